@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"net"
-	"sort"
 	"strconv"
 	"time"
 
@@ -16,22 +15,20 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var configFile string
 
 type Service struct {
 	api.UnimplementedSystemStatisticsServer
-	cache          systemdump.CacheSysStatDumps
-	requestCounter []int
-	conf           config.ServerConf
+	cache systemdump.CacheSysStatDumps
 }
 
 func NewService(conf config.Config) *Service {
 	return &Service{
-		cache:          systemdump.NewCacheSysStatDumps(conf),
-		requestCounter: make([]int, 0, 10),
-		conf:           conf.Server,
+		cache: systemdump.NewCacheSysStatDumps(conf),
 	}
 }
 
@@ -39,20 +36,29 @@ func (s *Service) StreamSystemDump(
 	in *api.GetSystemDumpRequest,
 	stream api.SystemStatistics_StreamSystemDumpServer,
 ) error {
-	s.addRequest(int(in.GetM()))
+	if err := s.cache.CheckSections(in.GetSections()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := systemdump.CheckFilter(in.GetFilter()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	n := s.addRequest(int(in.GetM()))
 	defer s.delRequest(int(in.GetM()))
 
 	logger.Log.WithFields(logrus.Fields{
 		"file": "grpc_server.go",
 		"func": "StreamSystemDump()",
-	}).Debug("call " + strconv.Itoa(len(s.requestCounter)) + " GRPC func")
+	}).Debug("call " + strconv.Itoa(n) + " GRPC func")
 
 	ticker := time.NewTicker(time.Duration(in.GetN()) * time.Second)
 	for { //nolint:all
 		select {
 		case <-ticker.C:
-			dump := s.cache.GetSysStatDumpOver(in.GetM())
-			if err := stream.Send(&api.GetSystemDumpResponse{SystemDump: dump}); err != nil {
+			dump, agg := s.cache.GetSysStatDumpOver(in)
+			if err := stream.Send(&api.GetSystemDumpResponse{
+				SystemDump:  dump,
+				Aggregation: agg,
+			}); err != nil {
 				logger.Log.WithFields(logrus.Fields{
 					"file": "grpc_server.go",
 				}).Error(err.Error())
@@ -69,53 +75,67 @@ func (s *Service) GetSystemDump(
 	in *api.GetSystemDumpRequest,
 ) (*api.GetSystemDumpResponse, error) {
 	_ = ctx
+	if err := s.cache.CheckSections(in.GetSections()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := systemdump.CheckFilter(in.GetFilter()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	s.addRequest(int(in.GetM()))
 	defer s.delRequest(int(in.GetM()))
 
-	dump := s.cache.GetSysStatDumpOver(in.GetM())
+	dump, agg := s.cache.GetSysStatDumpOver(in)
 
-	return &api.GetSystemDumpResponse{SystemDump: dump}, nil
+	return &api.GetSystemDumpResponse{SystemDump: dump, Aggregation: agg}, nil
 }
 
-func (s *Service) addRequest(m int) {
-	s.requestCounter = append(s.requestCounter, m)
-	if m > s.cache.Buffer.GetCapacity() {
-		s.cache.Buffer.ResizeCacheOfCap(m)
+func (s *Service) GetCollectorStatus(
+	ctx context.Context,
+	in *api.GetCollectorStatusRequest,
+) (*api.GetCollectorStatusResponse, error) {
+	_, _ = ctx, in
+
+	return &api.GetCollectorStatusResponse{Collectors: s.cache.CollectorStatus()}, nil
+}
+
+func (s *Service) GetSystemDumpHistory(
+	in *api.GetSystemDumpHistoryRequest,
+	stream api.SystemStatistics_GetSystemDumpHistoryServer,
+) error {
+	logger.Log.WithFields(logrus.Fields{
+		"file": "grpc_server.go",
+		"func": "GetSystemDumpHistory()",
+	}).Debug("call GRPC func")
 
+	err := s.cache.GetSysStatDumpHistory(in, func(dump *api.SystemDump) error {
+		return stream.Send(&api.GetSystemDumpHistoryResponse{SystemDump: dump})
+	})
+	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "grpc_server.go",
-		}).Debug("reallocate cache, current size = " + strconv.Itoa(m))
+		}).Error(err.Error())
 	}
+
+	return err
+}
+
+func (s *Service) addRequest(m int) int {
+	return s.cache.AddRequest(m)
 }
 
 func (s *Service) delRequest(m int) {
-	for i := range s.requestCounter {
-		if s.requestCounter[i] == m {
-			s.requestCounter = append(s.requestCounter[:i], s.requestCounter[i+1:]...)
-			break
-		}
-	}
+	n := s.cache.DelRequest(m)
 
 	logger.Log.WithFields(logrus.Fields{
 		"file": "grpc_server.go",
-	}).Debug("there is " + strconv.Itoa(len(s.requestCounter)) + " active GRPC funcs")
-
-	if len(s.requestCounter) == 0 {
-		s.cache.Buffer.ResizeCacheOfCap(s.conf.Capacity)
-		return
-	}
-	if m == s.cache.Buffer.GetCapacity() {
-		sort.Slice(s.requestCounter, func(i, j int) bool {
-			return s.requestCounter[i] > s.requestCounter[j]
-		})
-		s.cache.Buffer.ResizeCacheOfCap(s.requestCounter[0])
-	}
+	}).Debug("there is " + strconv.Itoa(n) + " active GRPC funcs")
 }
 
 func (s *Service) Start(port string) error {
 	if s.cache.StartDump() != nil {
 		return errors.New("error init cache")
 	}
+	defer s.cache.StopDump()
 	l, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
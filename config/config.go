@@ -3,15 +3,58 @@ package config
 import (
 	"fmt"
 	"io/ioutil" //nolint:all
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/valyala/fastjson"
+	"golang.org/x/exp/slices"
 )
 
 type Config struct {
 	Server     ServerConf
 	DumpFields DumpConf
 	LogLevel   string
+	// roots of procfs, sysfs and / of the monitored host
+	HostProc string
+	HostSys  string
+	HostRoot string
+	Storage  StorageConfig
+}
+
+// StorageConfig is the on-disk history of system dumps: segments of
+// SegmentSize bytes in Directory, removed when older than Retention or
+// when all of them take more than MaxSize bytes (zero - no limit).
+type StorageConfig struct {
+	Enable      bool
+	Directory   string
+	Retention   time.Duration
+	MaxSize     int64
+	SegmentSize int64
+	// from the finest resolution
+	Rollups []RollupConfig
+}
+
+// RollupConfig is a tier of averages of system dumps over Resolution,
+// they are removed like the segments of system dumps.
+type RollupConfig struct {
+	Resolution time.Duration
+	Retention  time.Duration
+	MaxSize    int64
+}
+
+// Defaults of the storage.
+const (
+	DefaultStorageRetention   = 24 * time.Hour
+	DefaultStorageSegmentSize = 8 * 1024 * 1024
+)
+
+// DefaultRollups are the tiers of the storage without Rollups.
+var DefaultRollups = []RollupConfig{
+	{Resolution: 10 * time.Second, Retention: 7 * 24 * time.Hour},
+	{Resolution: time.Minute, Retention: 30 * 24 * time.Hour},
+	{Resolution: 5 * time.Minute, Retention: 365 * 24 * time.Hour},
 }
 
 type ServerConf struct {
@@ -21,12 +64,221 @@ type ServerConf struct {
 
 type DumpConf struct {
 	ConnectStats      bool
-	DiskStats         bool
-	DiskUsage         bool
+	DiskStats         DiskStatsConfig
+	DiskUsage         DiskUsageConfig
 	LoadAverage       bool
 	LoadCPU           bool
 	LoadDisks         bool
+	Memory            bool
+	Pressure          bool
+	InterfaceStats    bool
+	ProtocolCounters  bool
+	TopProcesses      TopProcessesConfig
+	Cgroups           CgroupsConfig
 	NetworkTopTalkers TopTalkersConfig
+	// names of collectors enabled besides the sections above
+	Collectors []string
+	// intervals and timeouts of collectors by name
+	Schedule map[string]CollectorSchedule
+	Plugins  []PluginConfig
+	Textfile TextfileConfig
+}
+
+// TextfileConfig is a directory of *.prom and *.json files with metrics
+// written by other programs (cron jobs), see PluginConfig for the formats.
+type TextfileConfig struct {
+	Enable    bool
+	Directory string
+}
+
+// PluginConfig is an executable run by the collector PluginCollectorName(Name),
+// it prints metrics in JSON or Prometheus text format to stdout.
+type PluginConfig struct {
+	Name      string
+	Command   string
+	Args      []string
+	Format    string // PluginFormatJSON, PluginFormatPrometheus or empty to detect
+	MaxOutput int    // bytes of stdout, larger output is an error
+}
+
+// Output formats of plugins.
+const (
+	PluginFormatJSON       = "json"
+	PluginFormatPrometheus = "prometheus"
+)
+
+// Defaults of plugins, running a process every second is too much.
+const (
+	DefaultPluginInterval  = 10 * time.Second
+	DefaultPluginMaxOutput = 64 * 1024
+)
+
+// PluginCollectorPrefix starts the names of plugin collectors.
+const PluginCollectorPrefix = "Plugin:"
+
+// PluginCollectorName returns the name of the collector running the plugin.
+func PluginCollectorName(plugin string) string {
+	return PluginCollectorPrefix + plugin
+}
+
+// CollectorSchedule is how often a collector runs and how long a run may take
+// before its last good value is reported as stale.
+type CollectorSchedule struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// DefaultCollectorInterval is the interval of collectors missing in Schedule,
+// it is also the interval of the system dump snapshots.
+const DefaultCollectorInterval = time.Second
+
+// CollectorSchedule returns the schedule of the collector, the timeout
+// defaults to the interval.
+func (dc DumpConf) CollectorSchedule(name string) CollectorSchedule {
+	res := dc.Schedule[name]
+	if res.Interval <= 0 {
+		res.Interval = DefaultCollectorInterval
+	}
+	if res.Timeout <= 0 {
+		res.Timeout = res.Interval
+	}
+
+	return res
+}
+
+// Names of the built-in collectors, they match the keys of DumpFields.
+const (
+	CollectorConnectStats      = "ConnectStats"
+	CollectorDiskStats         = "DiskStats"
+	CollectorDiskUsage         = "DiskUsage"
+	CollectorLoadAverage       = "LoadAverage"
+	CollectorLoadCPU           = "LoadCPU"
+	CollectorLoadDisks         = "LoadDisks"
+	CollectorMemory            = "Memory"
+	CollectorPressure          = "Pressure"
+	CollectorInterfaceStats    = "InterfaceStats"
+	CollectorProtocolCounters  = "ProtocolCounters"
+	CollectorTopProcesses      = "TopProcesses"
+	CollectorCgroups           = "Cgroups"
+	CollectorTextfile          = "Textfile"
+	CollectorNetworkTopTalkers = "NetworkTopTalkers"
+)
+
+// EnabledCollectors returns the names of the enabled sections followed by
+// the extra Collectors, without duplicates.
+func (dc DumpConf) EnabledCollectors() []string {
+	res := make([]string, 0, 16)
+	for _, c := range []struct {
+		name   string
+		enable bool
+	}{
+		{CollectorLoadAverage, dc.LoadAverage},
+		{CollectorLoadCPU, dc.LoadCPU},
+		{CollectorDiskStats, dc.DiskStats.Enable},
+		{CollectorLoadDisks, dc.LoadDisks},
+		{CollectorDiskUsage, dc.DiskUsage.Enable},
+		{CollectorMemory, dc.Memory},
+		{CollectorPressure, dc.Pressure},
+		{CollectorInterfaceStats, dc.InterfaceStats},
+		{CollectorConnectStats, dc.ConnectStats},
+		{CollectorProtocolCounters, dc.ProtocolCounters},
+		{CollectorTopProcesses, dc.TopProcesses.Enable},
+		{CollectorCgroups, dc.Cgroups.Enable},
+		{CollectorTextfile, dc.Textfile.Enable},
+		{CollectorNetworkTopTalkers, dc.NetworkTopTalkers.Enable},
+	} {
+		if c.enable {
+			res = append(res, c.name)
+		}
+	}
+	for _, p := range dc.Plugins {
+		res = append(res, PluginCollectorName(p.Name))
+	}
+	for _, name := range dc.Collectors {
+		if !slices.Contains(res, name) {
+			res = append(res, name)
+		}
+	}
+
+	return res
+}
+
+// Sections of the system dump besides the ones named by the built-in collectors.
+const (
+	SectionCustomMetrics   = "CustomMetrics"
+	SectionCollectorStatus = "CollectorStatus"
+)
+
+// EnabledSections returns the names of the sections of the system dump
+// filled by the enabled collectors.
+func (dc DumpConf) EnabledSections() []string {
+	res := make([]string, 0, 16)
+	customMetrics := false
+	for _, name := range dc.EnabledCollectors() {
+		switch {
+		case name == CollectorTextfile || strings.HasPrefix(name, PluginCollectorPrefix):
+			customMetrics = true
+		case !slices.Contains(res, name):
+			res = append(res, name)
+		}
+	}
+	if customMetrics {
+		res = append(res, SectionCustomMetrics)
+	}
+
+	return append(res, SectionCollectorStatus)
+}
+
+type DiskStatsConfig struct {
+	Enable         bool
+	IncludeDevices *regexp.Regexp
+	ExcludeDevices *regexp.Regexp
+}
+
+type DiskUsageConfig struct {
+	Enable         bool
+	IncludeFSTypes []string
+	ExcludeFSTypes []string
+}
+
+type TopProcessesConfig struct {
+	Enable bool
+	K      int
+	SortBy string
+}
+
+// Sort keys of the top processes.
+const (
+	SortByCPU     = "cpu"
+	SortByRSS     = "rss"
+	SortByRead    = "read"
+	SortByWrite   = "write"
+	SortByFDs     = "fds"
+	SortByThreads = "threads"
+)
+
+var ProcessSortKeys = []string{SortByCPU, SortByRSS, SortByRead, SortByWrite, SortByFDs, SortByThreads}
+
+// Sort keys of the top talkers and the listening sockets.
+const (
+	SortByRate  = "rate"
+	SortByBytes = "bytes"
+	SortByBps   = "bps"
+	SortByPort  = "port"
+	SortByPid   = "pid"
+)
+
+// SortKeys are the sort keys of the requests, a section is sorted by its
+// default key if it has no such key.
+var SortKeys = append([]string{SortByRate, SortByBytes, SortByBps, SortByPort, SortByPid}, ProcessSortKeys...)
+
+// CgroupsConfig selects the cgroups down to Depth, Include and Exclude
+// are globs over the paths relative to the cgroup2 mount ("/system.slice/*").
+type CgroupsConfig struct {
+	Enable  bool
+	Depth   int
+	Include []string
+	Exclude []string
 }
 
 type TopTalkersConfig struct {
@@ -75,6 +327,26 @@ func NewConfig(fpath string) (c Config, err error) { //nolint:all
 		err = fmt.Errorf("not init Capacity parameters of Server in %s", fpath)
 		return
 	}
+	// parse Host parameters
+	c.HostProc, c.HostSys, c.HostRoot = "/proc", "/sys", "/"
+	if vv = v.Get("Host"); vv != nil {
+		if vv.Exists("Proc") {
+			c.HostProc = string(vv.GetStringBytes("Proc"))
+		}
+		if vv.Exists("Sys") {
+			c.HostSys = string(vv.GetStringBytes("Sys"))
+		}
+		if vv.Exists("Root") {
+			c.HostRoot = string(vv.GetStringBytes("Root"))
+		}
+	}
+	// parse Storage parameters
+	if vv = v.Get("Storage"); vv != nil {
+		if c.Storage, err = getStorage(vv); err != nil {
+			err = fmt.Errorf("wrong Storage in %s: %w", fpath, err)
+			return
+		}
+	}
 	// parse DumpFields parameters
 	if !v.Exists("DumpFields") {
 		err = fmt.Errorf("not init DumpFields config in %s", fpath)
@@ -90,27 +362,168 @@ func NewConfig(fpath string) (c Config, err error) { //nolint:all
 	if c.DumpFields.ConnectStats, err = strconv.ParseBool(string(vv.Get("ConnectStats").GetStringBytes())); err != nil {
 		return
 	}
-	if c.DumpFields.DiskStats, err = strconv.ParseBool(string(vv.Get("DiskStats").GetStringBytes())); err != nil {
+	if c.DumpFields.LoadAverage, err = strconv.ParseBool(string(vv.Get("LoadAverage").GetStringBytes())); err != nil {
 		return
 	}
-	if c.DumpFields.DiskUsage, err = strconv.ParseBool(string(vv.Get("DiskUsage").GetStringBytes())); err != nil {
+	if c.DumpFields.LoadCPU, err = strconv.ParseBool(string(vv.Get("LoadCPU").GetStringBytes())); err != nil {
 		return
 	}
-	if c.DumpFields.LoadAverage, err = strconv.ParseBool(string(vv.Get("LoadAverage").GetStringBytes())); err != nil {
+	if c.DumpFields.LoadDisks, err = strconv.ParseBool(string(vv.Get("LoadDisks").GetStringBytes())); err != nil {
 		return
 	}
-	if c.DumpFields.LoadCPU, err = strconv.ParseBool(string(vv.Get("LoadCPU").GetStringBytes())); err != nil {
+	if c.DumpFields.Memory, err = getBool(vv, "Memory"); err != nil {
 		return
 	}
-	if c.DumpFields.LoadDisks, err = strconv.ParseBool(string(vv.Get("LoadDisks").GetStringBytes())); err != nil {
+	if c.DumpFields.Pressure, err = getBool(vv, "Pressure"); err != nil {
+		return
+	}
+	if c.DumpFields.InterfaceStats, err = getBool(vv, "InterfaceStats"); err != nil {
 		return
 	}
+	if c.DumpFields.ProtocolCounters, err = getBool(vv, "ProtocolCounters"); err != nil {
+		return
+	}
+	// parse DiskStatsConfig parameters
+	// the legacy "DiskStats": "true" enables the section for all devices
+	vvv := vv.Get("DiskStats")
+	if vvv != nil && vvv.Type() == fastjson.TypeString {
+		if c.DumpFields.DiskStats.Enable, err = strconv.ParseBool(string(vvv.GetStringBytes())); err != nil {
+			return
+		}
+	} else {
+		if !vvv.Exists("Enable") {
+			err = fmt.Errorf("not init parameters of DiskStats in %s", fpath)
+			return
+		}
+		if c.DumpFields.DiskStats.Enable, err = strconv.ParseBool(string(vvv.Get("Enable").GetStringBytes())); err != nil {
+			return
+		}
+		if c.DumpFields.DiskStats.IncludeDevices, err = getRegexp(vvv, "IncludeDevices"); err != nil {
+			return
+		}
+		if c.DumpFields.DiskStats.ExcludeDevices, err = getRegexp(vvv, "ExcludeDevices"); err != nil {
+			return
+		}
+	}
+	// parse DiskUsageConfig parameters
+	// the legacy "DiskUsage": "true" enables the section for all file systems
+	vvv = vv.Get("DiskUsage")
+	if vvv != nil && vvv.Type() == fastjson.TypeString {
+		if c.DumpFields.DiskUsage.Enable, err = strconv.ParseBool(string(vvv.GetStringBytes())); err != nil {
+			return
+		}
+	} else {
+		if !vvv.Exists("Enable") {
+			err = fmt.Errorf("not init parameters of DiskUsage in %s", fpath)
+			return
+		}
+		if c.DumpFields.DiskUsage.Enable, err = strconv.ParseBool(string(vvv.Get("Enable").GetStringBytes())); err != nil {
+			return
+		}
+		c.DumpFields.DiskUsage.IncludeFSTypes = getStrings(vvv, "IncludeFSTypes")
+		c.DumpFields.DiskUsage.ExcludeFSTypes = getStrings(vvv, "ExcludeFSTypes")
+	}
+	// parse TopProcessesConfig parameters
+	if vvv = vv.Get("TopProcesses"); vvv != nil {
+		if c.DumpFields.TopProcesses.Enable, err = getBool(vvv, "Enable"); err != nil {
+			return
+		}
+		c.DumpFields.TopProcesses.K = 10
+		if vvv.Exists("K") {
+			if c.DumpFields.TopProcesses.K, err = strconv.Atoi(string(vvv.GetStringBytes("K"))); err != nil {
+				return
+			}
+		}
+		c.DumpFields.TopProcesses.SortBy = SortByCPU
+		if vvv.Exists("SortBy") {
+			c.DumpFields.TopProcesses.SortBy = string(vvv.GetStringBytes("SortBy"))
+		}
+		if !slices.Contains(ProcessSortKeys, c.DumpFields.TopProcesses.SortBy) {
+			err = fmt.Errorf("unknown SortBy of TopProcesses in %s", fpath)
+			return
+		}
+	}
+	// parse CgroupsConfig parameters
+	if vvv = vv.Get("Cgroups"); vvv != nil {
+		if c.DumpFields.Cgroups.Enable, err = getBool(vvv, "Enable"); err != nil {
+			return
+		}
+		c.DumpFields.Cgroups.Depth = 2
+		if vvv.Exists("Depth") {
+			if c.DumpFields.Cgroups.Depth, err = strconv.Atoi(string(vvv.GetStringBytes("Depth"))); err != nil {
+				return
+			}
+		}
+		c.DumpFields.Cgroups.Include = getStrings(vvv, "Include")
+		c.DumpFields.Cgroups.Exclude = getStrings(vvv, "Exclude")
+	}
+	// parse TextfileConfig parameters
+	if vvv = vv.Get("Textfile"); vvv != nil {
+		if c.DumpFields.Textfile.Enable, err = getBool(vvv, "Enable"); err != nil {
+			return
+		}
+		c.DumpFields.Textfile.Directory = string(vvv.GetStringBytes("Directory"))
+		if c.DumpFields.Textfile.Enable && c.DumpFields.Textfile.Directory == "" {
+			err = fmt.Errorf("not init Directory of Textfile in %s", fpath)
+			return
+		}
+	}
+	c.DumpFields.Collectors = getStrings(vv, "Collectors")
+	// parse Schedule of collectors: {"DiskUsage": {"Interval": "10s", "Timeout": "3s"}}
+	if vvv = vv.Get("Schedule"); vvv != nil {
+		var obj *fastjson.Object
+		if obj, err = vvv.Object(); err != nil {
+			return
+		}
+		c.DumpFields.Schedule = make(map[string]CollectorSchedule, obj.Len())
+		obj.Visit(func(key []byte, v *fastjson.Value) {
+			var cs CollectorSchedule
+			if err != nil {
+				return
+			}
+			if cs.Interval, err = getDuration(v, "Interval"); err != nil {
+				return
+			}
+			if cs.Timeout, err = getDuration(v, "Timeout"); err != nil {
+				return
+			}
+			c.DumpFields.Schedule[string(key)] = cs
+		})
+		if err != nil {
+			err = fmt.Errorf("wrong Schedule in %s: %w", fpath, err)
+			return
+		}
+	}
+	// parse Plugins: [{"Name": "raid", "Command": "/usr/local/bin/raid_check", "Interval": "30s"}]
+	for _, p := range vv.GetArray("Plugins") {
+		var pc PluginConfig
+		if pc, err = getPlugin(p); err != nil {
+			err = fmt.Errorf("wrong Plugins in %s: %w", fpath, err)
+			return
+		}
+		for i := range c.DumpFields.Plugins {
+			if c.DumpFields.Plugins[i].Name == pc.Name {
+				err = fmt.Errorf("plugin %s is repeated in %s", pc.Name, fpath)
+				return
+			}
+		}
+		cs := CollectorSchedule{Interval: DefaultPluginInterval}
+		if v, _ := getDuration(p, "Interval"); v > 0 {
+			cs.Interval = v
+		}
+		cs.Timeout, _ = getDuration(p, "Timeout")
+		if c.DumpFields.Schedule == nil {
+			c.DumpFields.Schedule = make(map[string]CollectorSchedule, 4)
+		}
+		c.DumpFields.Schedule[PluginCollectorName(pc.Name)] = cs
+		c.DumpFields.Plugins = append(c.DumpFields.Plugins, pc)
+	}
 	// parse TopTalkersConfig parameters
 	if !vv.Exists("NetworkTopTalkers") {
 		err = fmt.Errorf("not init NetworkTopTalkers config in %s", fpath)
 		return
 	}
-	vvv := vv.Get("NetworkTopTalkers")
+	vvv = vv.Get("NetworkTopTalkers")
 	if !vvv.Exists("Enable") {
 		err = fmt.Errorf("not init parameters of NetworkTopTalkers in %s", fpath)
 		return
@@ -138,3 +551,132 @@ func NewConfig(fpath string) (c Config, err error) { //nolint:all
 
 	return
 }
+
+// getStrings returns the optional array of strings under key.
+func getStrings(v *fastjson.Value, key string) []string {
+	arr := v.GetArray(key)
+	res := make([]string, 0, len(arr))
+	for i := range arr {
+		res = append(res, string(arr[i].GetStringBytes()))
+	}
+
+	return res
+}
+
+// getRegexp compiles the optional regular expression under key,
+// an empty expression gives nil.
+func getRegexp(v *fastjson.Value, key string) (*regexp.Regexp, error) {
+	expr := string(v.GetStringBytes(key))
+	if expr == "" {
+		return nil, nil
+	}
+
+	return regexp.Compile(expr)
+}
+
+func getPlugin(v *fastjson.Value) (pc PluginConfig, err error) {
+	pc.Name = string(v.GetStringBytes("Name"))
+	pc.Command = string(v.GetStringBytes("Command"))
+	if pc.Name == "" || pc.Command == "" {
+		return pc, fmt.Errorf("plugin must have Name and Command")
+	}
+	pc.Args = getStrings(v, "Args")
+	pc.Format = string(v.GetStringBytes("Format"))
+	if pc.Format != "" && pc.Format != PluginFormatJSON && pc.Format != PluginFormatPrometheus {
+		return pc, fmt.Errorf("unknown Format %s of plugin %s", pc.Format, pc.Name)
+	}
+	pc.MaxOutput = DefaultPluginMaxOutput
+	if v.Exists("MaxOutput") {
+		if pc.MaxOutput, err = strconv.Atoi(string(v.GetStringBytes("MaxOutput"))); err != nil {
+			return pc, err
+		}
+	}
+	if _, err = getDuration(v, "Interval"); err != nil {
+		return pc, err
+	}
+	_, err = getDuration(v, "Timeout")
+
+	return pc, err
+}
+
+func getStorage(v *fastjson.Value) (sc StorageConfig, err error) {
+	if sc.Enable, err = getBool(v, "Enable"); err != nil {
+		return
+	}
+	sc.Directory = string(v.GetStringBytes("Directory"))
+	if sc.Enable && sc.Directory == "" {
+		return sc, fmt.Errorf("not init Directory")
+	}
+	if sc.Retention, err = getDuration(v, "Retention"); err != nil {
+		return
+	}
+	if sc.Retention == 0 {
+		sc.Retention = DefaultStorageRetention
+	}
+	if sc.MaxSize, err = getInt64(v, "MaxSize"); err != nil {
+		return
+	}
+	if sc.SegmentSize, err = getInt64(v, "SegmentSize"); err != nil {
+		return
+	}
+	if sc.SegmentSize <= 0 {
+		sc.SegmentSize = DefaultStorageSegmentSize
+	}
+	// parse Rollups: [{"Resolution": "10s", "Retention": "168h", "MaxSize": "1073741824"}]
+	if !v.Exists("Rollups") {
+		sc.Rollups = slices.Clone(DefaultRollups)
+		return sc, nil
+	}
+	for _, r := range v.GetArray("Rollups") {
+		var rc RollupConfig
+		if rc.Resolution, err = getDuration(r, "Resolution"); err != nil {
+			return
+		}
+		if rc.Resolution < time.Second || rc.Resolution%time.Second != 0 {
+			return sc, fmt.Errorf("wrong Resolution of Rollups, it must be whole seconds")
+		}
+		if n := len(sc.Rollups); n > 0 && rc.Resolution <= sc.Rollups[n-1].Resolution {
+			return sc, fmt.Errorf("wrong order of Rollups, they must go from the finest Resolution")
+		}
+		if rc.Retention, err = getDuration(r, "Retention"); err != nil {
+			return
+		}
+		if rc.Retention == 0 {
+			rc.Retention = sc.Retention
+		}
+		if rc.MaxSize, err = getInt64(r, "MaxSize"); err != nil {
+			return
+		}
+		sc.Rollups = append(sc.Rollups, rc)
+	}
+
+	return sc, nil
+}
+
+// getInt64 parses the optional integer under key, a missing key gives zero.
+func getInt64(v *fastjson.Value, key string) (int64, error) {
+	if !v.Exists(key) {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(string(v.GetStringBytes(key)), 10, 64)
+}
+
+// getDuration parses the optional duration ("10s") under key,
+// a missing key gives zero.
+func getDuration(v *fastjson.Value, key string) (time.Duration, error) {
+	if !v.Exists(key) {
+		return 0, nil
+	}
+
+	return time.ParseDuration(string(v.GetStringBytes(key)))
+}
+
+// getBool parses the optional boolean under key, a missing key gives false.
+func getBool(v *fastjson.Value, key string) (bool, error) {
+	if !v.Exists(key) {
+		return false, nil
+	}
+
+	return strconv.ParseBool(string(v.GetStringBytes(key)))
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// baselineConfig is config.json of the first release, where the disk
+// sections are plain booleans.
+const baselineConfig = `{
+    "Logger": {
+        "Level": "Debug"
+    },
+    "Server": {
+        "Port": "8080",
+        "Capacity": "30",
+        "Timeout": "-1"
+    },
+    "DumpFields": {
+        "ConnectStats": "true",
+        "DiskStats": "true",
+        "DiskUsage": "true",
+        "LoadAverage": "true",
+        "LoadCPU": "true",
+        "LoadDisks": "true",
+        "NetworkTopTalkers": {
+            "Enable": "true",
+            "TCP": "true",
+            "UDP": "true",
+            "ICMP": "true"
+        }
+    }
+}`
+
+func loadConfig(t *testing.T, data string) (Config, error) {
+	t.Helper()
+	fpath := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(fpath, []byte(data), 0o600))
+
+	return NewConfig(fpath)
+}
+
+func TestNewConfig(t *testing.T) {
+	t.Run("baseline", func(t *testing.T) {
+		c, err := loadConfig(t, baselineConfig)
+		require.NoError(t, err)
+		require.True(t, c.DumpFields.DiskStats.Enable)
+		require.Nil(t, c.DumpFields.DiskStats.IncludeDevices)
+		require.Nil(t, c.DumpFields.DiskStats.ExcludeDevices)
+		require.True(t, c.DumpFields.DiskUsage.Enable)
+		require.True(t, c.DumpFields.ConnectStats)
+		require.True(t, c.DumpFields.NetworkTopTalkers.Enable)
+	})
+
+	t.Run("legacy disk stats", func(t *testing.T) {
+		c, err := loadConfig(t, strings.Replace(baselineConfig, `"DiskStats": "true"`, `"DiskStats": "false"`, 1))
+		require.NoError(t, err)
+		require.False(t, c.DumpFields.DiskStats.Enable)
+
+		_, err = loadConfig(t, strings.Replace(baselineConfig, `"DiskStats": "true"`, `"DiskStats": "on"`, 1))
+		require.Error(t, err)
+	})
+
+	t.Run("disk stats", func(t *testing.T) {
+		data := strings.Replace(baselineConfig, `"DiskStats": "true"`,
+			`"DiskStats": {"Enable": "true", "ExcludeDevices": "^loop"}`, 1)
+		c, err := loadConfig(t, data)
+		require.NoError(t, err)
+		require.True(t, c.DumpFields.DiskStats.Enable)
+		require.True(t, c.DumpFields.DiskStats.ExcludeDevices.MatchString("loop0"))
+
+		_, err = loadConfig(t, strings.Replace(data, `"Enable": "true", `, "", 1))
+		require.ErrorContains(t, err, "not init parameters of DiskStats")
+	})
+
+	t.Run("legacy disk usage", func(t *testing.T) {
+		data := strings.Replace(baselineConfig, `"DiskStats": "true"`, `"DiskStats": {"Enable": "true"}`, 1)
+		c, err := loadConfig(t, data)
+		require.NoError(t, err)
+		require.True(t, c.DumpFields.DiskUsage.Enable)
+		require.Empty(t, c.DumpFields.DiskUsage.IncludeFSTypes)
+		require.Empty(t, c.DumpFields.DiskUsage.ExcludeFSTypes)
+
+		c, err = loadConfig(t, strings.Replace(data, `"DiskUsage": "true"`, `"DiskUsage": "false"`, 1))
+		require.NoError(t, err)
+		require.False(t, c.DumpFields.DiskUsage.Enable)
+
+		_, err = loadConfig(t, strings.Replace(data, `"DiskUsage": "true"`, `"DiskUsage": "yes"`, 1))
+		require.Error(t, err)
+	})
+
+	t.Run("disk usage", func(t *testing.T) {
+		data := strings.Replace(baselineConfig, `"DiskStats": "true"`, `"DiskStats": {"Enable": "true"}`, 1)
+		data = strings.Replace(data, `"DiskUsage": "true"`,
+			`"DiskUsage": {"Enable": "true", "ExcludeFSTypes": ["tmpfs"]}`, 1)
+		c, err := loadConfig(t, data)
+		require.NoError(t, err)
+		require.True(t, c.DumpFields.DiskUsage.Enable)
+		require.Equal(t, []string{"tmpfs"}, c.DumpFields.DiskUsage.ExcludeFSTypes)
+
+		_, err = loadConfig(t, strings.Replace(data, `"Enable": "true", "ExcludeFSTypes"`, `"ExcludeFSTypes"`, 1))
+		require.ErrorContains(t, err, "not init parameters of DiskUsage")
+	})
+}
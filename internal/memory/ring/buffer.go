@@ -0,0 +1,206 @@
+package ring
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type item[T any] struct {
+	ts  time.Time
+	val T
+}
+
+// Buffer is a ring of values ordered by their timestamps. Appending and
+// evicting the oldest value is O(1).
+//
+// Without maxAge the buffer keeps the capacity newest values. With maxAge
+// it keeps the values not older than maxAge relative to the newest one and
+// grows over the capacity when needed.
+type Buffer[T any] struct {
+	mu     sync.RWMutex
+	items  []item[T]
+	head   int // index of the oldest value
+	size   int
+	maxAge time.Duration
+}
+
+// New returns an empty buffer.
+func New[T any](capacity int, maxAge time.Duration) *Buffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &Buffer[T]{
+		items:  make([]item[T], capacity),
+		maxAge: maxAge,
+	}
+}
+
+// at returns the index in items of the i-th oldest value.
+func (b *Buffer[T]) at(i int) int {
+	return (b.head + i) % len(b.items)
+}
+
+// Append adds the value. A value older than the newest one is moved into
+// place, which is cheap for nearly ordered streams (packets of several
+// interfaces).
+func (b *Buffer[T]) Append(ts time.Time, val T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.maxAge > 0 {
+		newest := ts
+		if b.size > 0 && b.items[b.at(b.size-1)].ts.After(ts) {
+			newest = b.items[b.at(b.size-1)].ts
+		}
+		for b.size > 0 && newest.Sub(b.items[b.head].ts) > b.maxAge {
+			b.evict()
+		}
+		if newest.Sub(ts) > b.maxAge {
+			return
+		}
+		if b.size == len(b.items) {
+			b.grow()
+		}
+	} else if b.size == len(b.items) {
+		if b.items[b.head].ts.After(ts) {
+			// older than everything kept
+			return
+		}
+		b.evict()
+	}
+
+	i := b.size
+	b.size++
+	for ; i > 0 && b.items[b.at(i-1)].ts.After(ts); i-- {
+		b.items[b.at(i)] = b.items[b.at(i-1)]
+	}
+	b.items[b.at(i)] = item[T]{ts: ts, val: val}
+}
+
+func (b *Buffer[T]) evict() {
+	b.items[b.head] = item[T]{}
+	b.head = (b.head + 1) % len(b.items)
+	b.size--
+}
+
+func (b *Buffer[T]) grow() {
+	b.resize(2 * len(b.items))
+}
+
+// resize copies the values into a new ring of the capacity, evicting the oldest.
+func (b *Buffer[T]) resize(capacity int) {
+	for b.size > capacity {
+		b.evict()
+	}
+	items := make([]item[T], capacity)
+	for i := 0; i < b.size; i++ {
+		items[i] = b.items[b.at(i)]
+	}
+	b.items, b.head = items, 0
+}
+
+// Resize changes the capacity, the oldest values over it are evicted.
+func (b *Buffer[T]) Resize(capacity int) {
+	if capacity < 1 {
+		capacity = 1
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.resize(capacity)
+}
+
+// Cap returns the capacity.
+func (b *Buffer[T]) Cap() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.items)
+}
+
+// Len returns the number of values.
+func (b *Buffer[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.size
+}
+
+// Newest returns the newest value and its timestamp.
+func (b *Buffer[T]) Newest() (time.Time, T, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.size == 0 {
+		var zero T
+		return time.Time{}, zero, false
+	}
+	newest := b.items[b.at(b.size-1)]
+
+	return newest.ts, newest.val, true
+}
+
+// Oldest returns the oldest value and its timestamp.
+func (b *Buffer[T]) Oldest() (time.Time, T, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.size == 0 {
+		var zero T
+		return time.Time{}, zero, false
+	}
+	oldest := b.items[b.head]
+
+	return oldest.ts, oldest.val, true
+}
+
+// Last returns the n newest values from the oldest to the newest.
+func (b *Buffer[T]) Last(n int) []T {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if n > b.size {
+		n = b.size
+	}
+
+	return b.values(b.size-n, b.size)
+}
+
+// Range returns the values with timestamps in [from, to] from the oldest to the newest.
+func (b *Buffer[T]) Range(from, to time.Time) []T {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	start := sort.Search(b.size, func(i int) bool {
+		return !b.items[b.at(i)].ts.Before(from)
+	})
+	end := sort.Search(b.size, func(i int) bool {
+		return b.items[b.at(i)].ts.After(to)
+	})
+	if start >= end {
+		return nil
+	}
+
+	return b.values(start, end)
+}
+
+// values copies the values from the i-th to the j-th oldest (exclusive).
+func (b *Buffer[T]) values(i, j int) []T {
+	res := make([]T, 0, j-i)
+	for ; i < j; i++ {
+		res = append(res, b.items[b.at(i)].val)
+	}
+
+	return res
+}
+
+// Clear removes all values.
+func (b *Buffer[T]) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.items = make([]item[T], len(b.items))
+	b.head, b.size = 0, 0
+}
@@ -0,0 +1,127 @@
+package ring
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var t0 = time.Unix(1700000000, 0)
+
+func at(sec int) time.Time {
+	return t0.Add(time.Duration(sec) * time.Second)
+}
+
+func TestBuffer(t *testing.T) {
+	t.Run("empty buffer", func(t *testing.T) {
+		b := New[int](3, 0)
+
+		require.Equal(t, 0, b.Len())
+		require.Empty(t, b.Last(2))
+		require.Empty(t, b.Range(at(0), at(10)))
+		_, _, ok := b.Newest()
+		require.False(t, ok)
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		b := New[int](3, 0)
+		for i := 1; i <= 5; i++ {
+			b.Append(at(i), i)
+		}
+
+		require.Equal(t, 3, b.Len())
+		require.Equal(t, []int{3, 4, 5}, b.Last(10))
+		require.Equal(t, []int{4, 5}, b.Last(2))
+		ts, v, ok := b.Newest()
+		require.True(t, ok)
+		require.Equal(t, at(5), ts)
+		require.Equal(t, 5, v)
+		ts, v, ok = b.Oldest()
+		require.True(t, ok)
+		require.Equal(t, at(3), ts)
+		require.Equal(t, 3, v)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		b := New[int](10, 0)
+		for i := 1; i <= 10; i++ {
+			b.Append(at(i), i)
+		}
+
+		require.Equal(t, []int{3, 4, 5}, b.Range(at(3), at(5)))
+		require.Equal(t, []int{1, 2}, b.Range(at(-5), at(2)))
+		require.Equal(t, []int{10}, b.Range(at(10), at(20)))
+		require.Empty(t, b.Range(at(11), at(20)))
+		require.Empty(t, b.Range(at(5), at(4)))
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		b := New[int](4, 0)
+		for _, i := range []int{1, 3, 2, 5, 4} {
+			b.Append(at(i), i)
+		}
+
+		require.Equal(t, []int{2, 3, 4, 5}, b.Last(4))
+		// older than everything kept in a full buffer
+		b.Append(at(1), 1)
+		require.Equal(t, []int{2, 3, 4, 5}, b.Last(4))
+	})
+
+	t.Run("resize", func(t *testing.T) {
+		b := New[int](2, 0)
+		for i := 1; i <= 3; i++ {
+			b.Append(at(i), i)
+		}
+		b.Resize(4)
+		b.Append(at(4), 4)
+		b.Append(at(5), 5)
+		require.Equal(t, 4, b.Cap())
+		require.Equal(t, []int{2, 3, 4, 5}, b.Last(4))
+
+		b.Resize(2)
+		require.Equal(t, []int{4, 5}, b.Last(4))
+
+		b.Clear()
+		require.Equal(t, 0, b.Len())
+		require.Equal(t, 2, b.Cap())
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		b := New[int](2, 3*time.Second)
+		for i := 1; i <= 6; i++ {
+			b.Append(at(i), i)
+		}
+		// grows over the capacity, keeps values not older than 3s
+		require.Equal(t, []int{3, 4, 5, 6}, b.Last(10))
+		require.GreaterOrEqual(t, b.Cap(), 4)
+
+		// too old
+		b.Append(at(1), 1)
+		require.Equal(t, []int{3, 4, 5, 6}, b.Last(10))
+
+		b.Append(at(20), 20)
+		require.Equal(t, []int{20}, b.Last(10))
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		b := New[int](10, time.Hour)
+		wg := sync.WaitGroup{}
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					b.Append(at(i), g)
+					b.Last(3)
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		require.Equal(t, 4000, b.Len())
+		values := b.Range(at(0), at(1000))
+		require.Len(t, values, 4000)
+	})
+}
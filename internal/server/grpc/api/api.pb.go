@@ -20,19 +20,89 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Aggregation int32
+
+const (
+	Aggregation_AGGREGATION_MEAN Aggregation = 0
+	Aggregation_AGGREGATION_MIN  Aggregation = 1
+	Aggregation_AGGREGATION_MAX  Aggregation = 2
+	Aggregation_AGGREGATION_LAST Aggregation = 3
+	Aggregation_AGGREGATION_P50  Aggregation = 4
+	Aggregation_AGGREGATION_P95  Aggregation = 5
+	Aggregation_AGGREGATION_P99  Aggregation = 6
+)
+
+// Enum value maps for Aggregation.
+var (
+	Aggregation_name = map[int32]string{
+		0: "AGGREGATION_MEAN",
+		1: "AGGREGATION_MIN",
+		2: "AGGREGATION_MAX",
+		3: "AGGREGATION_LAST",
+		4: "AGGREGATION_P50",
+		5: "AGGREGATION_P95",
+		6: "AGGREGATION_P99",
+	}
+	Aggregation_value = map[string]int32{
+		"AGGREGATION_MEAN": 0,
+		"AGGREGATION_MIN":  1,
+		"AGGREGATION_MAX":  2,
+		"AGGREGATION_LAST": 3,
+		"AGGREGATION_P50":  4,
+		"AGGREGATION_P95":  5,
+		"AGGREGATION_P99":  6,
+	}
+)
+
+func (x Aggregation) Enum() *Aggregation {
+	p := new(Aggregation)
+	*p = x
+	return p
+}
+
+func (x Aggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Aggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_api_proto_enumTypes[0].Descriptor()
+}
+
+func (Aggregation) Type() protoreflect.EnumType {
+	return &file_api_api_proto_enumTypes[0]
+}
+
+func (x Aggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Aggregation.Descriptor instead.
+func (Aggregation) EnumDescriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{0}
+}
+
 type SystemDump struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	LA *LoadAverage  `protobuf:"bytes,2,opt,name=l_a,json=lA,proto3" json:"l_a,omitempty"`
-	LC *LoadCPU      `protobuf:"bytes,3,opt,name=l_c,json=lC,proto3" json:"l_c,omitempty"`
-	DS *DiskStats    `protobuf:"bytes,4,opt,name=d_s,json=dS,proto3" json:"d_s,omitempty"`
-	LD []*LoadDisk   `protobuf:"bytes,5,rep,name=l_d,json=lD,proto3" json:"l_d,omitempty"`
-	DU []*DiskUsage  `protobuf:"bytes,6,rep,name=d_u,json=dU,proto3" json:"d_u,omitempty"`
-	TT *TopTalkers   `protobuf:"bytes,7,opt,name=t_t,json=tT,proto3" json:"t_t,omitempty"`
-	CS *ConnectStats `protobuf:"bytes,8,opt,name=c_s,json=cS,proto3" json:"c_s,omitempty"`
+	Id        string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LA        *LoadAverage       `protobuf:"bytes,2,opt,name=l_a,json=lA,proto3" json:"l_a,omitempty"`
+	LC        *LoadCPU           `protobuf:"bytes,3,opt,name=l_c,json=lC,proto3" json:"l_c,omitempty"`
+	DS        []*DiskStats       `protobuf:"bytes,4,rep,name=d_s,json=dS,proto3" json:"d_s,omitempty"`
+	LD        []*LoadDisk        `protobuf:"bytes,5,rep,name=l_d,json=lD,proto3" json:"l_d,omitempty"`
+	DU        []*DiskUsage       `protobuf:"bytes,6,rep,name=d_u,json=dU,proto3" json:"d_u,omitempty"`
+	TT        *TopTalkers        `protobuf:"bytes,7,opt,name=t_t,json=tT,proto3" json:"t_t,omitempty"`
+	CS        *ConnectStats      `protobuf:"bytes,8,opt,name=c_s,json=cS,proto3" json:"c_s,omitempty"`
+	MS        *MemoryStats       `protobuf:"bytes,9,opt,name=m_s,json=mS,proto3" json:"m_s,omitempty"`
+	PSI       *Pressure          `protobuf:"bytes,10,opt,name=p_s_i,json=pSI,proto3" json:"p_s_i,omitempty"`
+	IS        []*InterfaceStats  `protobuf:"bytes,11,rep,name=i_s,json=iS,proto3" json:"i_s,omitempty"`
+	PC        *ProtocolCounters  `protobuf:"bytes,12,opt,name=p_c,json=pC,proto3" json:"p_c,omitempty"`
+	TP        *TopProcesses      `protobuf:"bytes,13,opt,name=t_p,json=tP,proto3" json:"t_p,omitempty"`
+	CG        []*CgroupStats     `protobuf:"bytes,14,rep,name=c_g,json=cG,proto3" json:"c_g,omitempty"`
+	CSt       []*CollectorStatus `protobuf:"bytes,15,rep,name=c_st,json=cSt,proto3" json:"c_st,omitempty"`
+	CM        []*CustomMetric    `protobuf:"bytes,16,rep,name=c_m,json=cM,proto3" json:"c_m,omitempty"`
+	Timestamp int64              `protobuf:"varint,17,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *SystemDump) Reset() {
@@ -88,7 +158,7 @@ func (x *SystemDump) GetLC() *LoadCPU {
 	return nil
 }
 
-func (x *SystemDump) GetDS() *DiskStats {
+func (x *SystemDump) GetDS() []*DiskStats {
 	if x != nil {
 		return x.DS
 	}
@@ -123,6 +193,69 @@ func (x *SystemDump) GetCS() *ConnectStats {
 	return nil
 }
 
+func (x *SystemDump) GetMS() *MemoryStats {
+	if x != nil {
+		return x.MS
+	}
+	return nil
+}
+
+func (x *SystemDump) GetPSI() *Pressure {
+	if x != nil {
+		return x.PSI
+	}
+	return nil
+}
+
+func (x *SystemDump) GetIS() []*InterfaceStats {
+	if x != nil {
+		return x.IS
+	}
+	return nil
+}
+
+func (x *SystemDump) GetPC() *ProtocolCounters {
+	if x != nil {
+		return x.PC
+	}
+	return nil
+}
+
+func (x *SystemDump) GetTP() *TopProcesses {
+	if x != nil {
+		return x.TP
+	}
+	return nil
+}
+
+func (x *SystemDump) GetCG() []*CgroupStats {
+	if x != nil {
+		return x.CG
+	}
+	return nil
+}
+
+func (x *SystemDump) GetCSt() []*CollectorStatus {
+	if x != nil {
+		return x.CSt
+	}
+	return nil
+}
+
+func (x *SystemDump) GetCM() []*CustomMetric {
+	if x != nil {
+		return x.CM
+	}
+	return nil
+}
+
+func (x *SystemDump) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
 type LoadAverage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -191,9 +324,15 @@ type LoadCPU struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserMode   float64 `protobuf:"fixed64,1,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
-	SystemMode float64 `protobuf:"fixed64,2,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
-	Idle       float64 `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	UserMode   float64     `protobuf:"fixed64,1,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
+	SystemMode float64     `protobuf:"fixed64,2,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
+	Idle       float64     `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	Nice       float64     `protobuf:"fixed64,4,opt,name=nice,proto3" json:"nice,omitempty"`
+	Iowait     float64     `protobuf:"fixed64,5,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	Irq        float64     `protobuf:"fixed64,6,opt,name=irq,proto3" json:"irq,omitempty"`
+	Softirq    float64     `protobuf:"fixed64,7,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	Steal      float64     `protobuf:"fixed64,8,opt,name=steal,proto3" json:"steal,omitempty"`
+	Cores      []*LoadCore `protobuf:"bytes,9,rep,name=cores,proto3" json:"cores,omitempty"`
 }
 
 func (x *LoadCPU) Reset() {
@@ -249,6 +388,159 @@ func (x *LoadCPU) GetIdle() float64 {
 	return 0
 }
 
+func (x *LoadCPU) GetNice() float64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *LoadCPU) GetIowait() float64 {
+	if x != nil {
+		return x.Iowait
+	}
+	return 0
+}
+
+func (x *LoadCPU) GetIrq() float64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *LoadCPU) GetSoftirq() float64 {
+	if x != nil {
+		return x.Softirq
+	}
+	return 0
+}
+
+func (x *LoadCPU) GetSteal() float64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
+func (x *LoadCPU) GetCores() []*LoadCore {
+	if x != nil {
+		return x.Cores
+	}
+	return nil
+}
+
+type LoadCore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cpu        string  `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	UserMode   float64 `protobuf:"fixed64,2,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
+	SystemMode float64 `protobuf:"fixed64,3,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
+	Idle       float64 `protobuf:"fixed64,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	Nice       float64 `protobuf:"fixed64,5,opt,name=nice,proto3" json:"nice,omitempty"`
+	Iowait     float64 `protobuf:"fixed64,6,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	Irq        float64 `protobuf:"fixed64,7,opt,name=irq,proto3" json:"irq,omitempty"`
+	Softirq    float64 `protobuf:"fixed64,8,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	Steal      float64 `protobuf:"fixed64,9,opt,name=steal,proto3" json:"steal,omitempty"`
+}
+
+func (x *LoadCore) Reset() {
+	*x = LoadCore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadCore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadCore) ProtoMessage() {}
+
+func (x *LoadCore) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadCore.ProtoReflect.Descriptor instead.
+func (*LoadCore) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LoadCore) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *LoadCore) GetUserMode() float64 {
+	if x != nil {
+		return x.UserMode
+	}
+	return 0
+}
+
+func (x *LoadCore) GetSystemMode() float64 {
+	if x != nil {
+		return x.SystemMode
+	}
+	return 0
+}
+
+func (x *LoadCore) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *LoadCore) GetNice() float64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *LoadCore) GetIowait() float64 {
+	if x != nil {
+		return x.Iowait
+	}
+	return 0
+}
+
+func (x *LoadCore) GetIrq() float64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *LoadCore) GetSoftirq() float64 {
+	if x != nil {
+		return x.Softirq
+	}
+	return 0
+}
+
+func (x *LoadCore) GetSteal() float64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
 type DiskStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -257,12 +549,14 @@ type DiskStats struct {
 	IoTime       float64 `protobuf:"fixed64,1,opt,name=io_time,json=ioTime,proto3" json:"io_time,omitempty"`
 	IoInProgress float64 `protobuf:"fixed64,2,opt,name=io_in_progress,json=ioInProgress,proto3" json:"io_in_progress,omitempty"`
 	WeightedIo   float64 `protobuf:"fixed64,3,opt,name=weighted_io,json=weightedIo,proto3" json:"weighted_io,omitempty"`
+	Device       string  `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	Partition    bool    `protobuf:"varint,5,opt,name=partition,proto3" json:"partition,omitempty"`
 }
 
 func (x *DiskStats) Reset() {
 	*x = DiskStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[3]
+		mi := &file_api_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -275,7 +569,7 @@ func (x *DiskStats) String() string {
 func (*DiskStats) ProtoMessage() {}
 
 func (x *DiskStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[3]
+	mi := &file_api_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +582,7 @@ func (x *DiskStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskStats.ProtoReflect.Descriptor instead.
 func (*DiskStats) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{3}
+	return file_api_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DiskStats) GetIoTime() float64 {
@@ -312,6 +606,20 @@ func (x *DiskStats) GetWeightedIo() float64 {
 	return 0
 }
 
+func (x *DiskStats) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskStats) GetPartition() bool {
+	if x != nil {
+		return x.Partition
+	}
+	return false
+}
+
 type LoadDisk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -322,12 +630,15 @@ type LoadDisk struct {
 	KbRps      float64 `protobuf:"fixed64,3,opt,name=kb_rps,json=kbRps,proto3" json:"kb_rps,omitempty"`
 	KbWps      float64 `protobuf:"fixed64,4,opt,name=kb_wps,json=kbWps,proto3" json:"kb_wps,omitempty"`
 	KbPs       float64 `protobuf:"fixed64,5,opt,name=kb_ps,json=kbPs,proto3" json:"kb_ps,omitempty"`
+	Await      float64 `protobuf:"fixed64,6,opt,name=await,proto3" json:"await,omitempty"`
+	Util       float64 `protobuf:"fixed64,7,opt,name=util,proto3" json:"util,omitempty"`
+	QueueDepth float64 `protobuf:"fixed64,8,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
 }
 
 func (x *LoadDisk) Reset() {
 	*x = LoadDisk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[4]
+		mi := &file_api_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -340,7 +651,7 @@ func (x *LoadDisk) String() string {
 func (*LoadDisk) ProtoMessage() {}
 
 func (x *LoadDisk) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[4]
+	mi := &file_api_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +664,7 @@ func (x *LoadDisk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadDisk.ProtoReflect.Descriptor instead.
 func (*LoadDisk) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{4}
+	return file_api_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoadDisk) GetDiskDevice() string {
@@ -391,6 +702,27 @@ func (x *LoadDisk) GetKbPs() float64 {
 	return 0
 }
 
+func (x *LoadDisk) GetAwait() float64 {
+	if x != nil {
+		return x.Await
+	}
+	return 0
+}
+
+func (x *LoadDisk) GetUtil() float64 {
+	if x != nil {
+		return x.Util
+	}
+	return 0
+}
+
+func (x *LoadDisk) GetQueueDepth() float64 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
 type DiskUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -401,12 +733,18 @@ type DiskUsage struct {
 	Use        uint64 `protobuf:"varint,3,opt,name=use,proto3" json:"use,omitempty"`
 	Iused      uint64 `protobuf:"varint,4,opt,name=iused,proto3" json:"iused,omitempty"`
 	Iuse       uint64 `protobuf:"varint,5,opt,name=iuse,proto3" json:"iuse,omitempty"`
+	MountPoint string `protobuf:"bytes,6,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	FsType     string `protobuf:"bytes,7,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	Size       uint64 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Avail      uint64 `protobuf:"varint,9,opt,name=avail,proto3" json:"avail,omitempty"`
+	Inodes     uint64 `protobuf:"varint,10,opt,name=inodes,proto3" json:"inodes,omitempty"`
+	Ifree      uint64 `protobuf:"varint,11,opt,name=ifree,proto3" json:"ifree,omitempty"`
 }
 
 func (x *DiskUsage) Reset() {
 	*x = DiskUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[5]
+		mi := &file_api_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -419,7 +757,7 @@ func (x *DiskUsage) String() string {
 func (*DiskUsage) ProtoMessage() {}
 
 func (x *DiskUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[5]
+	mi := &file_api_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +770,7 @@ func (x *DiskUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
 func (*DiskUsage) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{5}
+	return file_api_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DiskUsage) GetFileSystem() string {
@@ -470,87 +808,1601 @@ func (x *DiskUsage) GetIuse() uint64 {
 	return 0
 }
 
-type TopTalkers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ttp []*TopTalkersProtocol `protobuf:"bytes,1,rep,name=ttp,proto3" json:"ttp,omitempty"`
-	Ttt []*TopTalkersTraffic  `protobuf:"bytes,2,rep,name=ttt,proto3" json:"ttt,omitempty"`
+func (x *DiskUsage) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
 }
 
-func (x *TopTalkers) Reset() {
-	*x = TopTalkers{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DiskUsage) GetFsType() string {
+	if x != nil {
+		return x.FsType
 	}
+	return ""
 }
 
-func (x *TopTalkers) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DiskUsage) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (*TopTalkers) ProtoMessage() {}
+func (x *DiskUsage) GetAvail() uint64 {
+	if x != nil {
+		return x.Avail
+	}
+	return 0
+}
 
-func (x *TopTalkers) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DiskUsage) GetInodes() uint64 {
+	if x != nil {
+		return x.Inodes
 	}
-	return mi.MessageOf(x)
+	return 0
+}
+
+func (x *DiskUsage) GetIfree() uint64 {
+	if x != nil {
+		return x.Ifree
+	}
+	return 0
+}
+
+type MemoryStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     uint64  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Available uint64  `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Used      uint64  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Buffers   uint64  `protobuf:"varint,4,opt,name=buffers,proto3" json:"buffers,omitempty"`
+	Cached    uint64  `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`
+	Slab      uint64  `protobuf:"varint,6,opt,name=slab,proto3" json:"slab,omitempty"`
+	Dirty     uint64  `protobuf:"varint,7,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	Writeback uint64  `protobuf:"varint,8,opt,name=writeback,proto3" json:"writeback,omitempty"`
+	SwapTotal uint64  `protobuf:"varint,9,opt,name=swap_total,json=swapTotal,proto3" json:"swap_total,omitempty"`
+	SwapUsed  uint64  `protobuf:"varint,10,opt,name=swap_used,json=swapUsed,proto3" json:"swap_used,omitempty"`
+	SwapFree  uint64  `protobuf:"varint,11,opt,name=swap_free,json=swapFree,proto3" json:"swap_free,omitempty"`
+	PageIn    float64 `protobuf:"fixed64,12,opt,name=page_in,json=pageIn,proto3" json:"page_in,omitempty"`
+	PageOut   float64 `protobuf:"fixed64,13,opt,name=page_out,json=pageOut,proto3" json:"page_out,omitempty"`
+	SwapIn    float64 `protobuf:"fixed64,14,opt,name=swap_in,json=swapIn,proto3" json:"swap_in,omitempty"`
+	SwapOut   float64 `protobuf:"fixed64,15,opt,name=swap_out,json=swapOut,proto3" json:"swap_out,omitempty"`
+}
+
+func (x *MemoryStats) Reset() {
+	*x = MemoryStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStats) ProtoMessage() {}
+
+func (x *MemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryStats.ProtoReflect.Descriptor instead.
+func (*MemoryStats) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MemoryStats) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetBuffers() uint64 {
+	if x != nil {
+		return x.Buffers
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetCached() uint64 {
+	if x != nil {
+		return x.Cached
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetSlab() uint64 {
+	if x != nil {
+		return x.Slab
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetDirty() uint64 {
+	if x != nil {
+		return x.Dirty
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetWriteback() uint64 {
+	if x != nil {
+		return x.Writeback
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetSwapTotal() uint64 {
+	if x != nil {
+		return x.SwapTotal
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetSwapUsed() uint64 {
+	if x != nil {
+		return x.SwapUsed
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetSwapFree() uint64 {
+	if x != nil {
+		return x.SwapFree
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetPageIn() float64 {
+	if x != nil {
+		return x.PageIn
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetPageOut() float64 {
+	if x != nil {
+		return x.PageOut
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetSwapIn() float64 {
+	if x != nil {
+		return x.SwapIn
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetSwapOut() float64 {
+	if x != nil {
+		return x.SwapOut
+	}
+	return 0
+}
+
+type Pressure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Cpu    *PressureResource `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory *PressureResource `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Io     *PressureResource `protobuf:"bytes,4,opt,name=io,proto3" json:"io,omitempty"`
+}
+
+func (x *Pressure) Reset() {
+	*x = Pressure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pressure) ProtoMessage() {}
+
+func (x *Pressure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pressure.ProtoReflect.Descriptor instead.
+func (*Pressure) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Pressure) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Pressure) GetCpu() *PressureResource {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *Pressure) GetMemory() *PressureResource {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *Pressure) GetIo() *PressureResource {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+type PressureResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Some *PressureLine `protobuf:"bytes,1,opt,name=some,proto3" json:"some,omitempty"`
+	Full *PressureLine `protobuf:"bytes,2,opt,name=full,proto3" json:"full,omitempty"`
+}
+
+func (x *PressureResource) Reset() {
+	*x = PressureResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PressureResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PressureResource) ProtoMessage() {}
+
+func (x *PressureResource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PressureResource.ProtoReflect.Descriptor instead.
+func (*PressureResource) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PressureResource) GetSome() *PressureLine {
+	if x != nil {
+		return x.Some
+	}
+	return nil
+}
+
+func (x *PressureResource) GetFull() *PressureLine {
+	if x != nil {
+		return x.Full
+	}
+	return nil
+}
+
+type PressureLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Avg10  float64 `protobuf:"fixed64,1,opt,name=avg10,proto3" json:"avg10,omitempty"`
+	Avg60  float64 `protobuf:"fixed64,2,opt,name=avg60,proto3" json:"avg60,omitempty"`
+	Avg300 float64 `protobuf:"fixed64,3,opt,name=avg300,proto3" json:"avg300,omitempty"`
+	Total  float64 `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *PressureLine) Reset() {
+	*x = PressureLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PressureLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PressureLine) ProtoMessage() {}
+
+func (x *PressureLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PressureLine.ProtoReflect.Descriptor instead.
+func (*PressureLine) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PressureLine) GetAvg10() float64 {
+	if x != nil {
+		return x.Avg10
+	}
+	return 0
+}
+
+func (x *PressureLine) GetAvg60() float64 {
+	if x != nil {
+		return x.Avg60
+	}
+	return 0
+}
+
+func (x *PressureLine) GetAvg300() float64 {
+	if x != nil {
+		return x.Avg300
+	}
+	return 0
+}
+
+func (x *PressureLine) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type InterfaceStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxBps     float64 `protobuf:"fixed64,2,opt,name=rx_bps,json=rxBps,proto3" json:"rx_bps,omitempty"`
+	TxBps     float64 `protobuf:"fixed64,3,opt,name=tx_bps,json=txBps,proto3" json:"tx_bps,omitempty"`
+	RxPps     float64 `protobuf:"fixed64,4,opt,name=rx_pps,json=rxPps,proto3" json:"rx_pps,omitempty"`
+	TxPps     float64 `protobuf:"fixed64,5,opt,name=tx_pps,json=txPps,proto3" json:"tx_pps,omitempty"`
+	RxErrors  float64 `protobuf:"fixed64,6,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	TxErrors  float64 `protobuf:"fixed64,7,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	RxDrops   float64 `protobuf:"fixed64,8,opt,name=rx_drops,json=rxDrops,proto3" json:"rx_drops,omitempty"`
+	TxDrops   float64 `protobuf:"fixed64,9,opt,name=tx_drops,json=txDrops,proto3" json:"tx_drops,omitempty"`
+	Multicast float64 `protobuf:"fixed64,10,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	Mtu       uint32  `protobuf:"varint,11,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Operstate string  `protobuf:"bytes,12,opt,name=operstate,proto3" json:"operstate,omitempty"`
+	Speed     int64   `protobuf:"varint,13,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *InterfaceStats) Reset() {
+	*x = InterfaceStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceStats) ProtoMessage() {}
+
+func (x *InterfaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceStats.ProtoReflect.Descriptor instead.
+func (*InterfaceStats) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InterfaceStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InterfaceStats) GetRxBps() float64 {
+	if x != nil {
+		return x.RxBps
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxBps() float64 {
+	if x != nil {
+		return x.TxBps
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetRxPps() float64 {
+	if x != nil {
+		return x.RxPps
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxPps() float64 {
+	if x != nil {
+		return x.TxPps
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetRxErrors() float64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxErrors() float64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetRxDrops() float64 {
+	if x != nil {
+		return x.RxDrops
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetTxDrops() float64 {
+	if x != nil {
+		return x.TxDrops
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetMulticast() float64 {
+	if x != nil {
+		return x.Multicast
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *InterfaceStats) GetOperstate() string {
+	if x != nil {
+		return x.Operstate
+	}
+	return ""
+}
+
+func (x *InterfaceStats) GetSpeed() int64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type TopTalkers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ttp []*TopTalkersProtocol `protobuf:"bytes,1,rep,name=ttp,proto3" json:"ttp,omitempty"`
+	Ttt []*TopTalkersTraffic  `protobuf:"bytes,2,rep,name=ttt,proto3" json:"ttt,omitempty"`
+}
+
+func (x *TopTalkers) Reset() {
+	*x = TopTalkers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopTalkers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopTalkers) ProtoMessage() {}
+
+func (x *TopTalkers) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 // Deprecated: Use TopTalkers.ProtoReflect.Descriptor instead.
 func (*TopTalkers) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{6}
+	return file_api_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TopTalkers) GetTtp() []*TopTalkersProtocol {
+	if x != nil {
+		return x.Ttp
+	}
+	return nil
+}
+
+func (x *TopTalkers) GetTtt() []*TopTalkersTraffic {
+	if x != nil {
+		return x.Ttt
+	}
+	return nil
+}
+
+type ConnectStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ls   []*ListeningSocket `protobuf:"bytes,1,rep,name=ls,proto3" json:"ls,omitempty"`
+	Conn []*Connect         `protobuf:"bytes,2,rep,name=conn,proto3" json:"conn,omitempty"`
+}
+
+func (x *ConnectStats) Reset() {
+	*x = ConnectStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectStats) ProtoMessage() {}
+
+func (x *ConnectStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectStats.ProtoReflect.Descriptor instead.
+func (*ConnectStats) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConnectStats) GetLs() []*ListeningSocket {
+	if x != nil {
+		return x.Ls
+	}
+	return nil
+}
+
+func (x *ConnectStats) GetConn() []*Connect {
+	if x != nil {
+		return x.Conn
+	}
+	return nil
+}
+
+type ProtocolCounters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveOpens     float64 `protobuf:"fixed64,1,opt,name=active_opens,json=activeOpens,proto3" json:"active_opens,omitempty"`
+	PassiveOpens    float64 `protobuf:"fixed64,2,opt,name=passive_opens,json=passiveOpens,proto3" json:"passive_opens,omitempty"`
+	OutSegs         float64 `protobuf:"fixed64,3,opt,name=out_segs,json=outSegs,proto3" json:"out_segs,omitempty"`
+	RetransSegs     float64 `protobuf:"fixed64,4,opt,name=retrans_segs,json=retransSegs,proto3" json:"retrans_segs,omitempty"`
+	RetransRate     float64 `protobuf:"fixed64,5,opt,name=retrans_rate,json=retransRate,proto3" json:"retrans_rate,omitempty"`
+	OutRsts         float64 `protobuf:"fixed64,6,opt,name=out_rsts,json=outRsts,proto3" json:"out_rsts,omitempty"`
+	TcpInErrors     float64 `protobuf:"fixed64,7,opt,name=tcp_in_errors,json=tcpInErrors,proto3" json:"tcp_in_errors,omitempty"`
+	ListenOverflows float64 `protobuf:"fixed64,8,opt,name=listen_overflows,json=listenOverflows,proto3" json:"listen_overflows,omitempty"`
+	ListenDrops     float64 `protobuf:"fixed64,9,opt,name=listen_drops,json=listenDrops,proto3" json:"listen_drops,omitempty"`
+	SyncookiesSent  float64 `protobuf:"fixed64,10,opt,name=syncookies_sent,json=syncookiesSent,proto3" json:"syncookies_sent,omitempty"`
+	UdpRcvbufErrors float64 `protobuf:"fixed64,11,opt,name=udp_rcvbuf_errors,json=udpRcvbufErrors,proto3" json:"udp_rcvbuf_errors,omitempty"`
+	UdpInErrors     float64 `protobuf:"fixed64,12,opt,name=udp_in_errors,json=udpInErrors,proto3" json:"udp_in_errors,omitempty"`
+	IcmpInErrors    float64 `protobuf:"fixed64,13,opt,name=icmp_in_errors,json=icmpInErrors,proto3" json:"icmp_in_errors,omitempty"`
+	IcmpOutErrors   float64 `protobuf:"fixed64,14,opt,name=icmp_out_errors,json=icmpOutErrors,proto3" json:"icmp_out_errors,omitempty"`
+}
+
+func (x *ProtocolCounters) Reset() {
+	*x = ProtocolCounters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolCounters) ProtoMessage() {}
+
+func (x *ProtocolCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolCounters.ProtoReflect.Descriptor instead.
+func (*ProtocolCounters) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProtocolCounters) GetActiveOpens() float64 {
+	if x != nil {
+		return x.ActiveOpens
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetPassiveOpens() float64 {
+	if x != nil {
+		return x.PassiveOpens
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetOutSegs() float64 {
+	if x != nil {
+		return x.OutSegs
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetRetransSegs() float64 {
+	if x != nil {
+		return x.RetransSegs
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetRetransRate() float64 {
+	if x != nil {
+		return x.RetransRate
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetOutRsts() float64 {
+	if x != nil {
+		return x.OutRsts
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetTcpInErrors() float64 {
+	if x != nil {
+		return x.TcpInErrors
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetListenOverflows() float64 {
+	if x != nil {
+		return x.ListenOverflows
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetListenDrops() float64 {
+	if x != nil {
+		return x.ListenDrops
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetSyncookiesSent() float64 {
+	if x != nil {
+		return x.SyncookiesSent
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetUdpRcvbufErrors() float64 {
+	if x != nil {
+		return x.UdpRcvbufErrors
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetUdpInErrors() float64 {
+	if x != nil {
+		return x.UdpInErrors
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetIcmpInErrors() float64 {
+	if x != nil {
+		return x.IcmpInErrors
+	}
+	return 0
+}
+
+func (x *ProtocolCounters) GetIcmpOutErrors() float64 {
+	if x != nil {
+		return x.IcmpOutErrors
+	}
+	return 0
+}
+
+type TopProcesses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortBy    string     `protobuf:"bytes,1,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Processes []*Process `protobuf:"bytes,2,rep,name=processes,proto3" json:"processes,omitempty"`
+}
+
+func (x *TopProcesses) Reset() {
+	*x = TopProcesses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopProcesses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopProcesses) ProtoMessage() {}
+
+func (x *TopProcesses) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopProcesses.ProtoReflect.Descriptor instead.
+func (*TopProcesses) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TopProcesses) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *TopProcesses) GetProcesses() []*Process {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type Process struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid      uint32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid     uint32  `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	User     string  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Command  string  `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	Cpu      float64 `protobuf:"fixed64,5,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Rss      uint64  `protobuf:"varint,6,opt,name=rss,proto3" json:"rss,omitempty"`
+	ReadBps  float64 `protobuf:"fixed64,7,opt,name=read_bps,json=readBps,proto3" json:"read_bps,omitempty"`
+	WriteBps float64 `protobuf:"fixed64,8,opt,name=write_bps,json=writeBps,proto3" json:"write_bps,omitempty"`
+	Fds      uint32  `protobuf:"varint,9,opt,name=fds,proto3" json:"fds,omitempty"`
+	Threads  uint32  `protobuf:"varint,10,opt,name=threads,proto3" json:"threads,omitempty"`
+}
+
+func (x *Process) Reset() {
+	*x = Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Process) ProtoMessage() {}
+
+func (x *Process) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Process.ProtoReflect.Descriptor instead.
+func (*Process) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Process) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *Process) GetPpid() uint32 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *Process) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Process) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Process) GetCpu() float64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *Process) GetRss() uint64 {
+	if x != nil {
+		return x.Rss
+	}
+	return 0
+}
+
+func (x *Process) GetReadBps() float64 {
+	if x != nil {
+		return x.ReadBps
+	}
+	return 0
+}
+
+func (x *Process) GetWriteBps() float64 {
+	if x != nil {
+		return x.WriteBps
+	}
+	return 0
+}
+
+func (x *Process) GetFds() uint32 {
+	if x != nil {
+		return x.Fds
+	}
+	return 0
+}
+
+func (x *Process) GetThreads() uint32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+type CgroupStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path          string      `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	CpuUsage      float64     `protobuf:"fixed64,2,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	CpuUser       float64     `protobuf:"fixed64,3,opt,name=cpu_user,json=cpuUser,proto3" json:"cpu_user,omitempty"`
+	CpuSystem     float64     `protobuf:"fixed64,4,opt,name=cpu_system,json=cpuSystem,proto3" json:"cpu_system,omitempty"`
+	NrThrottled   float64     `protobuf:"fixed64,5,opt,name=nr_throttled,json=nrThrottled,proto3" json:"nr_throttled,omitempty"`
+	ThrottledTime float64     `protobuf:"fixed64,6,opt,name=throttled_time,json=throttledTime,proto3" json:"throttled_time,omitempty"`
+	MemoryCurrent uint64      `protobuf:"varint,7,opt,name=memory_current,json=memoryCurrent,proto3" json:"memory_current,omitempty"`
+	MemoryMax     uint64      `protobuf:"varint,8,opt,name=memory_max,json=memoryMax,proto3" json:"memory_max,omitempty"`
+	MemoryOom     uint64      `protobuf:"varint,9,opt,name=memory_oom,json=memoryOom,proto3" json:"memory_oom,omitempty"`
+	MemoryOomKill uint64      `protobuf:"varint,10,opt,name=memory_oom_kill,json=memoryOomKill,proto3" json:"memory_oom_kill,omitempty"`
+	Io            []*CgroupIO `protobuf:"bytes,11,rep,name=io,proto3" json:"io,omitempty"`
+	PidsCurrent   uint64      `protobuf:"varint,12,opt,name=pids_current,json=pidsCurrent,proto3" json:"pids_current,omitempty"`
+}
+
+func (x *CgroupStats) Reset() {
+	*x = CgroupStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupStats) ProtoMessage() {}
+
+func (x *CgroupStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupStats.ProtoReflect.Descriptor instead.
+func (*CgroupStats) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CgroupStats) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CgroupStats) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetCpuUser() float64 {
+	if x != nil {
+		return x.CpuUser
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetCpuSystem() float64 {
+	if x != nil {
+		return x.CpuSystem
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetNrThrottled() float64 {
+	if x != nil {
+		return x.NrThrottled
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetThrottledTime() float64 {
+	if x != nil {
+		return x.ThrottledTime
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetMemoryCurrent() uint64 {
+	if x != nil {
+		return x.MemoryCurrent
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetMemoryMax() uint64 {
+	if x != nil {
+		return x.MemoryMax
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetMemoryOom() uint64 {
+	if x != nil {
+		return x.MemoryOom
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetMemoryOomKill() uint64 {
+	if x != nil {
+		return x.MemoryOomKill
+	}
+	return 0
+}
+
+func (x *CgroupStats) GetIo() []*CgroupIO {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+func (x *CgroupStats) GetPidsCurrent() uint64 {
+	if x != nil {
+		return x.PidsCurrent
+	}
+	return 0
+}
+
+type CgroupIO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device string  `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Rbps   float64 `protobuf:"fixed64,2,opt,name=rbps,proto3" json:"rbps,omitempty"`
+	Wbps   float64 `protobuf:"fixed64,3,opt,name=wbps,proto3" json:"wbps,omitempty"`
+	Rios   float64 `protobuf:"fixed64,4,opt,name=rios,proto3" json:"rios,omitempty"`
+	Wios   float64 `protobuf:"fixed64,5,opt,name=wios,proto3" json:"wios,omitempty"`
+}
+
+func (x *CgroupIO) Reset() {
+	*x = CgroupIO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupIO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupIO) ProtoMessage() {}
+
+func (x *CgroupIO) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupIO.ProtoReflect.Descriptor instead.
+func (*CgroupIO) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CgroupIO) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CgroupIO) GetRbps() float64 {
+	if x != nil {
+		return x.Rbps
+	}
+	return 0
+}
+
+func (x *CgroupIO) GetWbps() float64 {
+	if x != nil {
+		return x.Wbps
+	}
+	return 0
+}
+
+func (x *CgroupIO) GetRios() float64 {
+	if x != nil {
+		return x.Rios
+	}
+	return 0
+}
+
+func (x *CgroupIO) GetWios() float64 {
+	if x != nil {
+		return x.Wios
+	}
+	return 0
+}
+
+type CollectorStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LastSuccess int64   `protobuf:"varint,2,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError   string  `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Duration    float64 `protobuf:"fixed64,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Stale       bool    `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *CollectorStatus) Reset() {
+	*x = CollectorStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorStatus) ProtoMessage() {}
+
+func (x *CollectorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorStatus.ProtoReflect.Descriptor instead.
+func (*CollectorStatus) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CollectorStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectorStatus) GetLastSuccess() int64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *CollectorStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CollectorStatus) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CollectorStatus) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type CustomMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Value  float64           `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Unit   string            `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Source string            `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Mtime  int64             `protobuf:"varint,6,opt,name=mtime,proto3" json:"mtime,omitempty"`
+}
+
+func (x *CustomMetric) Reset() {
+	*x = CustomMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomMetric) ProtoMessage() {}
+
+func (x *CustomMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomMetric.ProtoReflect.Descriptor instead.
+func (*CustomMetric) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CustomMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomMetric) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CustomMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CustomMetric) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CustomMetric) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CustomMetric) GetMtime() int64 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+type TopTalkersProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Bytes    uint32 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Rate     uint32 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *TopTalkersProtocol) Reset() {
+	*x = TopTalkersProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopTalkersProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopTalkersProtocol) ProtoMessage() {}
+
+func (x *TopTalkersProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopTalkersProtocol.ProtoReflect.Descriptor instead.
+func (*TopTalkersProtocol) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TopTalkersProtocol) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TopTalkersProtocol) GetBytes() uint32 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *TopTalkersProtocol) GetRate() uint32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type TopTalkersTraffic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Distination string `protobuf:"bytes,2,opt,name=distination,proto3" json:"distination,omitempty"`
+	Protocol    string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Bps         uint32 `protobuf:"varint,4,opt,name=bps,proto3" json:"bps,omitempty"`
+}
+
+func (x *TopTalkersTraffic) Reset() {
+	*x = TopTalkersTraffic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopTalkersTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopTalkersTraffic) ProtoMessage() {}
+
+func (x *TopTalkersTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopTalkersTraffic.ProtoReflect.Descriptor instead.
+func (*TopTalkersTraffic) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TopTalkersTraffic) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TopTalkersTraffic) GetDistination() string {
+	if x != nil {
+		return x.Distination
+	}
+	return ""
+}
+
+func (x *TopTalkersTraffic) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TopTalkersTraffic) GetBps() uint32 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+type ListeningSocket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port     uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Pid      uint32 `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	User     string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Command  string `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *ListeningSocket) Reset() {
+	*x = ListeningSocket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListeningSocket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListeningSocket) ProtoMessage() {}
+
+func (x *ListeningSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *TopTalkers) GetTtp() []*TopTalkersProtocol {
+// Deprecated: Use ListeningSocket.ProtoReflect.Descriptor instead.
+func (*ListeningSocket) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListeningSocket) GetProtocol() string {
 	if x != nil {
-		return x.Ttp
+		return x.Protocol
 	}
-	return nil
+	return ""
 }
 
-func (x *TopTalkers) GetTtt() []*TopTalkersTraffic {
+func (x *ListeningSocket) GetPort() uint32 {
 	if x != nil {
-		return x.Ttt
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-type ConnectStats struct {
+func (x *ListeningSocket) GetPid() uint32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ListeningSocket) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListeningSocket) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type Connect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ls   []*ListeningSocket `protobuf:"bytes,1,rep,name=ls,proto3" json:"ls,omitempty"`
-	Conn []*Connect         `protobuf:"bytes,2,rep,name=conn,proto3" json:"conn,omitempty"`
+	State  string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (x *ConnectStats) Reset() {
-	*x = ConnectStats{}
+func (x *Connect) Reset() {
+	*x = Connect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[7]
+		mi := &file_api_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectStats) String() string {
+func (x *Connect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectStats) ProtoMessage() {}
+func (*Connect) ProtoMessage() {}
 
-func (x *ConnectStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[7]
+func (x *Connect) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,52 +2413,58 @@ func (x *ConnectStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectStats.ProtoReflect.Descriptor instead.
-func (*ConnectStats) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Connect.ProtoReflect.Descriptor instead.
+func (*Connect) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ConnectStats) GetLs() []*ListeningSocket {
+func (x *Connect) GetState() string {
 	if x != nil {
-		return x.Ls
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *ConnectStats) GetConn() []*Connect {
+func (x *Connect) GetNumber() uint32 {
 	if x != nil {
-		return x.Conn
+		return x.Number
 	}
-	return nil
+	return 0
 }
 
-type TopTalkersProtocol struct {
+type GetSystemDumpRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Bytes    uint32 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Rate     uint32 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	N                  uint32      `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	M                  uint32      `protobuf:"varint,2,opt,name=m,proto3" json:"m,omitempty"`
+	TopProcessesK      uint32      `protobuf:"varint,3,opt,name=top_processes_k,json=topProcessesK,proto3" json:"top_processes_k,omitempty"`
+	TopProcessesSortBy string      `protobuf:"bytes,4,opt,name=top_processes_sort_by,json=topProcessesSortBy,proto3" json:"top_processes_sort_by,omitempty"`
+	Aggregation        Aggregation `protobuf:"varint,5,opt,name=aggregation,proto3,enum=api.Aggregation" json:"aggregation,omitempty"`
+	Sections           []string    `protobuf:"bytes,6,rep,name=sections,proto3" json:"sections,omitempty"`
+	Filter             *DumpFilter `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	TopK               uint32      `protobuf:"varint,8,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	SortBy             string      `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 }
 
-func (x *TopTalkersProtocol) Reset() {
-	*x = TopTalkersProtocol{}
+func (x *GetSystemDumpRequest) Reset() {
+	*x = GetSystemDumpRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[8]
+		mi := &file_api_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopTalkersProtocol) String() string {
+func (x *GetSystemDumpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopTalkersProtocol) ProtoMessage() {}
+func (*GetSystemDumpRequest) ProtoMessage() {}
 
-func (x *TopTalkersProtocol) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[8]
+func (x *GetSystemDumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,60 +2475,106 @@ func (x *TopTalkersProtocol) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopTalkersProtocol.ProtoReflect.Descriptor instead.
-func (*TopTalkersProtocol) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetSystemDumpRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemDumpRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *TopTalkersProtocol) GetProtocol() string {
+func (x *GetSystemDumpRequest) GetN() uint32 {
 	if x != nil {
-		return x.Protocol
+		return x.N
 	}
-	return ""
+	return 0
 }
 
-func (x *TopTalkersProtocol) GetBytes() uint32 {
+func (x *GetSystemDumpRequest) GetM() uint32 {
 	if x != nil {
-		return x.Bytes
+		return x.M
 	}
 	return 0
 }
 
-func (x *TopTalkersProtocol) GetRate() uint32 {
+func (x *GetSystemDumpRequest) GetTopProcessesK() uint32 {
 	if x != nil {
-		return x.Rate
+		return x.TopProcessesK
 	}
 	return 0
 }
 
-type TopTalkersTraffic struct {
+func (x *GetSystemDumpRequest) GetTopProcessesSortBy() string {
+	if x != nil {
+		return x.TopProcessesSortBy
+	}
+	return ""
+}
+
+func (x *GetSystemDumpRequest) GetAggregation() Aggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return Aggregation_AGGREGATION_MEAN
+}
+
+func (x *GetSystemDumpRequest) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *GetSystemDumpRequest) GetFilter() *DumpFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetSystemDumpRequest) GetTopK() uint32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *GetSystemDumpRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+type DumpFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Distination string `protobuf:"bytes,2,opt,name=distination,proto3" json:"distination,omitempty"`
-	Protocol    string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Bps         uint32 `protobuf:"varint,4,opt,name=bps,proto3" json:"bps,omitempty"`
+	Device      string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	PortMin     uint32 `protobuf:"varint,2,opt,name=port_min,json=portMin,proto3" json:"port_min,omitempty"`
+	PortMax     uint32 `protobuf:"varint,3,opt,name=port_max,json=portMax,proto3" json:"port_max,omitempty"`
+	Protocol    string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Source      string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination,omitempty"`
+	User        string `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	Command     string `protobuf:"bytes,8,opt,name=command,proto3" json:"command,omitempty"`
 }
 
-func (x *TopTalkersTraffic) Reset() {
-	*x = TopTalkersTraffic{}
+func (x *DumpFilter) Reset() {
+	*x = DumpFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[9]
+		mi := &file_api_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopTalkersTraffic) String() string {
+func (x *DumpFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopTalkersTraffic) ProtoMessage() {}
+func (*DumpFilter) ProtoMessage() {}
 
-func (x *TopTalkersTraffic) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[9]
+func (x *DumpFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,68 +2585,93 @@ func (x *TopTalkersTraffic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopTalkersTraffic.ProtoReflect.Descriptor instead.
-func (*TopTalkersTraffic) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DumpFilter.ProtoReflect.Descriptor instead.
+func (*DumpFilter) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TopTalkersTraffic) GetSource() string {
+func (x *DumpFilter) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DumpFilter) GetPortMin() uint32 {
+	if x != nil {
+		return x.PortMin
+	}
+	return 0
+}
+
+func (x *DumpFilter) GetPortMax() uint32 {
+	if x != nil {
+		return x.PortMax
+	}
+	return 0
+}
+
+func (x *DumpFilter) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *DumpFilter) GetSource() string {
 	if x != nil {
 		return x.Source
 	}
 	return ""
 }
 
-func (x *TopTalkersTraffic) GetDistination() string {
+func (x *DumpFilter) GetDestination() string {
 	if x != nil {
-		return x.Distination
+		return x.Destination
 	}
 	return ""
 }
 
-func (x *TopTalkersTraffic) GetProtocol() string {
+func (x *DumpFilter) GetUser() string {
 	if x != nil {
-		return x.Protocol
+		return x.User
 	}
 	return ""
 }
 
-func (x *TopTalkersTraffic) GetBps() uint32 {
+func (x *DumpFilter) GetCommand() string {
 	if x != nil {
-		return x.Bps
+		return x.Command
 	}
-	return 0
+	return ""
 }
 
-type ListeningSocket struct {
+type GetSystemDumpResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Port     uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Pid      uint32 `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
-	User     string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	Command  string `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	SystemDump  *SystemDump `protobuf:"bytes,1,opt,name=system_dump,json=systemDump,proto3" json:"system_dump,omitempty"`
+	Aggregation Aggregation `protobuf:"varint,2,opt,name=aggregation,proto3,enum=api.Aggregation" json:"aggregation,omitempty"`
 }
 
-func (x *ListeningSocket) Reset() {
-	*x = ListeningSocket{}
+func (x *GetSystemDumpResponse) Reset() {
+	*x = GetSystemDumpResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[10]
+		mi := &file_api_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListeningSocket) String() string {
+func (x *GetSystemDumpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListeningSocket) ProtoMessage() {}
+func (*GetSystemDumpResponse) ProtoMessage() {}
 
-func (x *ListeningSocket) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[10]
+func (x *GetSystemDumpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,72 +2682,90 @@ func (x *ListeningSocket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListeningSocket.ProtoReflect.Descriptor instead.
-func (*ListeningSocket) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetSystemDumpResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemDumpResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListeningSocket) GetProtocol() string {
+func (x *GetSystemDumpResponse) GetSystemDump() *SystemDump {
 	if x != nil {
-		return x.Protocol
+		return x.SystemDump
 	}
-	return ""
+	return nil
 }
 
-func (x *ListeningSocket) GetPort() uint32 {
+func (x *GetSystemDumpResponse) GetAggregation() Aggregation {
 	if x != nil {
-		return x.Port
+		return x.Aggregation
 	}
-	return 0
+	return Aggregation_AGGREGATION_MEAN
 }
 
-func (x *ListeningSocket) GetPid() uint32 {
-	if x != nil {
-		return x.Pid
-	}
-	return 0
+type GetCollectorStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListeningSocket) GetUser() string {
-	if x != nil {
-		return x.User
+func (x *GetCollectorStatusRequest) Reset() {
+	*x = GetCollectorStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListeningSocket) GetCommand() string {
-	if x != nil {
-		return x.Command
+func (x *GetCollectorStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectorStatusRequest) ProtoMessage() {}
+
+func (x *GetCollectorStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type Connect struct {
+// Deprecated: Use GetCollectorStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectorStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{28}
+}
+
+type GetSystemDumpHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State  string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	From int64  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Step uint32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
 }
 
-func (x *Connect) Reset() {
-	*x = Connect{}
+func (x *GetSystemDumpHistoryRequest) Reset() {
+	*x = GetSystemDumpHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[11]
+		mi := &file_api_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Connect) String() string {
+func (x *GetSystemDumpHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connect) ProtoMessage() {}
+func (*GetSystemDumpHistoryRequest) ProtoMessage() {}
 
-func (x *Connect) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[11]
+func (x *GetSystemDumpHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,51 +2776,57 @@ func (x *Connect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connect.ProtoReflect.Descriptor instead.
-func (*Connect) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetSystemDumpHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemDumpHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Connect) GetState() string {
+func (x *GetSystemDumpHistoryRequest) GetFrom() int64 {
 	if x != nil {
-		return x.State
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *Connect) GetNumber() uint32 {
+func (x *GetSystemDumpHistoryRequest) GetTo() int64 {
 	if x != nil {
-		return x.Number
+		return x.To
 	}
 	return 0
 }
 
-type GetSystemDumpRequest struct {
+func (x *GetSystemDumpHistoryRequest) GetStep() uint32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+type GetSystemDumpHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	N uint32 `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
-	M uint32 `protobuf:"varint,2,opt,name=m,proto3" json:"m,omitempty"`
+	SystemDump *SystemDump `protobuf:"bytes,1,opt,name=system_dump,json=systemDump,proto3" json:"system_dump,omitempty"`
 }
 
-func (x *GetSystemDumpRequest) Reset() {
-	*x = GetSystemDumpRequest{}
+func (x *GetSystemDumpHistoryResponse) Reset() {
+	*x = GetSystemDumpHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[12]
+		mi := &file_api_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSystemDumpRequest) String() string {
+func (x *GetSystemDumpHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemDumpRequest) ProtoMessage() {}
+func (*GetSystemDumpHistoryResponse) ProtoMessage() {}
 
-func (x *GetSystemDumpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[12]
+func (x *GetSystemDumpHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,50 +2837,43 @@ func (x *GetSystemDumpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemDumpRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemDumpRequest) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GetSystemDumpRequest) GetN() uint32 {
-	if x != nil {
-		return x.N
-	}
-	return 0
+// Deprecated: Use GetSystemDumpHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemDumpHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetSystemDumpRequest) GetM() uint32 {
+func (x *GetSystemDumpHistoryResponse) GetSystemDump() *SystemDump {
 	if x != nil {
-		return x.M
+		return x.SystemDump
 	}
-	return 0
+	return nil
 }
 
-type GetSystemDumpResponse struct {
+type GetCollectorStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SystemDump *SystemDump `protobuf:"bytes,1,opt,name=system_dump,json=systemDump,proto3" json:"system_dump,omitempty"`
+	Collectors []*CollectorStatus `protobuf:"bytes,1,rep,name=collectors,proto3" json:"collectors,omitempty"`
 }
 
-func (x *GetSystemDumpResponse) Reset() {
-	*x = GetSystemDumpResponse{}
+func (x *GetCollectorStatusResponse) Reset() {
+	*x = GetCollectorStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_api_proto_msgTypes[13]
+		mi := &file_api_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSystemDumpResponse) String() string {
+func (x *GetCollectorStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemDumpResponse) ProtoMessage() {}
+func (*GetCollectorStatusResponse) ProtoMessage() {}
 
-func (x *GetSystemDumpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_api_proto_msgTypes[13]
+func (x *GetCollectorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,14 +2884,14 @@ func (x *GetSystemDumpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemDumpResponse.ProtoReflect.Descriptor instead.
-func (*GetSystemDumpResponse) Descriptor() ([]byte, []int) {
-	return file_api_api_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetCollectorStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetSystemDumpResponse) GetSystemDump() *SystemDump {
+func (x *GetCollectorStatusResponse) GetCollectors() []*CollectorStatus {
 	if x != nil {
-		return x.SystemDump
+		return x.Collectors
 	}
 	return nil
 }
@@ -954,13 +2900,13 @@ var File_api_api_proto protoreflect.FileDescriptor
 
 var file_api_api_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x22, 0x86, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
+	0x03, 0x61, 0x70, 0x69, 0x22, 0xcc, 0x04, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
 	0x75, 0x6d, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x03, 0x6c, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61,
 	0x67, 0x65, 0x52, 0x02, 0x6c, 0x41, 0x12, 0x1d, 0x0a, 0x03, 0x6c, 0x5f, 0x63, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x50,
-	0x55, 0x52, 0x02, 0x6c, 0x43, 0x12, 0x1f, 0x0a, 0x03, 0x64, 0x5f, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x55, 0x52, 0x02, 0x6c, 0x43, 0x12, 0x1f, 0x0a, 0x03, 0x64, 0x5f, 0x73, 0x18, 0x04, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
 	0x74, 0x73, 0x52, 0x02, 0x64, 0x53, 0x12, 0x1e, 0x0a, 0x03, 0x6c, 0x5f, 0x64, 0x18, 0x05, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69,
@@ -970,28 +2916,75 @@ var file_api_api_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x54, 0x61,
 	0x6c, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x02, 0x74, 0x54, 0x12, 0x22, 0x0a, 0x03, 0x63, 0x5f, 0x73,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x02, 0x63, 0x53, 0x22, 0x77, 0x0a,
-	0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b,
-	0x61, 0x76, 0x67, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x4f, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0c,
-	0x61, 0x76, 0x67, 0x5f, 0x66, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0a, 0x61, 0x76, 0x67, 0x46, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x26,
-	0x0a, 0x0f, 0x61, 0x76, 0x67, 0x5f, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x5f, 0x6d, 0x69,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x46, 0x69, 0x66, 0x74,
-	0x65, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x22, 0x5b, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x50,
-	0x55, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69,
-	0x64, 0x6c, 0x65, 0x22, 0x6b, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x06, 0x69, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6f, 0x5f,
-	0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0c, 0x69, 0x6f, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x49, 0x6f,
-	0x22, 0x80, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x1f, 0x0a,
+	0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x02, 0x63, 0x53, 0x12, 0x21, 0x0a,
+	0x03, 0x6d, 0x5f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x02, 0x6d, 0x53,
+	0x12, 0x21, 0x0a, 0x05, 0x70, 0x5f, 0x73, 0x5f, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x52, 0x03,
+	0x70, 0x53, 0x49, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x5f, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x02, 0x69, 0x53, 0x12, 0x26, 0x0a, 0x03, 0x70, 0x5f, 0x63,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x02, 0x70,
+	0x43, 0x12, 0x22, 0x0a, 0x03, 0x74, 0x5f, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x02, 0x74, 0x50, 0x12, 0x21, 0x0a, 0x03, 0x63, 0x5f, 0x67, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x02, 0x63, 0x47, 0x12, 0x27, 0x0a, 0x04, 0x63, 0x5f, 0x73, 0x74,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x03, 0x63, 0x53,
+	0x74, 0x12, 0x22, 0x0a, 0x03, 0x63, 0x5f, 0x6d, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x52, 0x02, 0x63, 0x4d, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x77, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x76, 0x67, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x67, 0x4f, 0x6e, 0x65, 0x4d,
+	0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x5f, 0x66, 0x69, 0x76, 0x65, 0x5f, 0x6d,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x76, 0x67, 0x46, 0x69, 0x76,
+	0x65, 0x4d, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x76, 0x67, 0x5f, 0x66, 0x69, 0x66, 0x74,
+	0x65, 0x65, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61,
+	0x76, 0x67, 0x46, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x22, 0xee, 0x01, 0x0a,
+	0x07, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x50, 0x55, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72, 0x71, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x66, 0x74,
+	0x69, 0x72, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x66, 0x74, 0x69,
+	0x72, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x05, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x61, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xdc, 0x01,
+	0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70,
+	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72,
+	0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73,
+	0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x22, 0xa1, 0x01, 0x0a,
+	0x09, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x69, 0x6f, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6f, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6f, 0x49,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x49, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xcb, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x1f, 0x0a,
 	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10,
 	0x0a, 0x03, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x74, 0x70, 0x73,
@@ -999,72 +2992,338 @@ var file_api_api_proto_rawDesc = []byte{
 	0x52, 0x05, 0x6b, 0x62, 0x52, 0x70, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x62, 0x5f, 0x77, 0x70,
 	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6b, 0x62, 0x57, 0x70, 0x73, 0x12, 0x13,
 	0x0a, 0x05, 0x6b, 0x62, 0x5f, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6b,
-	0x62, 0x50, 0x73, 0x22, 0x7c, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x75, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x75, 0x73, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x69, 0x75, 0x73,
-	0x65, 0x22, 0x61, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73, 0x12,
-	0x29, 0x0a, 0x03, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x03, 0x74, 0x74, 0x70, 0x12, 0x28, 0x0a, 0x03, 0x74, 0x74,
-	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
-	0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
-	0x03, 0x74, 0x74, 0x74, 0x22, 0x56, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x02, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x02, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x6f,
-	0x6e, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x6e, 0x22, 0x5a, 0x0a, 0x12,
-	0x54, 0x6f, 0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x7b, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x54,
-	0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x62, 0x70, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x37, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
-	0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x01, 0x6d, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d,
-	0x70, 0x32, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4d, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x44, 0x75, 0x6d, 0x70, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
-	0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42,
-	0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x62, 0x50, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x61, 0x77, 0x61, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x74, 0x69,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x75, 0x74, 0x69, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x8e,
+	0x02, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x75, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x69, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x75, 0x73,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x69, 0x75, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x66, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x66, 0x72,
+	0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x66, 0x72, 0x65, 0x65, 0x22,
+	0x90, 0x03, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x61,
+	0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x61, 0x62, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x69, 0x72, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x69,
+	0x72, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x46, 0x72, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x4f,
+	0x75, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x03, 0x63, 0x70, 0x75,
+	0x12, 0x2d, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x25, 0x0a, 0x02, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x02, 0x69, 0x6f, 0x22, 0x60, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x68, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x76, 0x67, 0x31,
+	0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x76, 0x67, 0x31, 0x30, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x76, 0x67, 0x36, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61,
+	0x76, 0x67, 0x36, 0x30, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x67, 0x33, 0x30, 0x30, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x76, 0x67, 0x33, 0x30, 0x30, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0xd4, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x78, 0x5f,
+	0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x78, 0x42, 0x70, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x74, 0x78, 0x42, 0x70, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x78, 0x5f, 0x70, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x78, 0x50, 0x70, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x78, 0x5f, 0x70, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x74, 0x78, 0x50, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78,
+	0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x78,
+	0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x0a, 0x54, 0x6f, 0x70,
+	0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x03, 0x74, 0x74, 0x70, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x54, 0x61,
+	0x6c, 0x6b, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x03, 0x74,
+	0x74, 0x70, 0x12, 0x28, 0x0a, 0x03, 0x74, 0x74, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x03, 0x74, 0x74, 0x74, 0x22, 0x56, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x02,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x02,
+	0x6c, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x04,
+	0x63, 0x6f, 0x6e, 0x6e, 0x22, 0x8f, 0x04, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x53, 0x65, 0x67, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x73, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x52, 0x73, 0x74, 0x73, 0x12, 0x22, 0x0a,
+	0x0d, 0x74, 0x63, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f,
+	0x6b, 0x69, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f,
+	0x72, 0x63, 0x76, 0x62, 0x75, 0x66, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x75, 0x64, 0x70, 0x52, 0x63, 0x76, 0x62, 0x75, 0x66, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x64, 0x70,
+	0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x63, 0x6d, 0x70,
+	0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x53, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x2a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x70, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x70, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x42, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x64, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x66, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x22, 0x91, 0x03, 0x0a, 0x0b, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x70, 0x75, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x70, 0x75, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6e, 0x72, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x68, 0x72, 0x6f, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x6f, 0x6d, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x6f, 0x6d, 0x5f, 0x6b, 0x69, 0x6c, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x6f,
+	0x6d, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x4f,
+	0x52, 0x02, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x69, 0x64, 0x73, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x69, 0x64, 0x73,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x08, 0x43, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x4f, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x62, 0x70, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x77, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x77,
+	0x62, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x72, 0x69, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6f, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x77, 0x69, 0x6f, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x54, 0x61, 0x6c,
+	0x6b, 0x65, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x22, 0x7b, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x54, 0x61, 0x6c, 0x6b, 0x65, 0x72, 0x73,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x10, 0x0a,
+	0x03, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x62, 0x70, 0x73, 0x22,
+	0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x22, 0x37, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xb4, 0x02, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01,
+	0x6d, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x5f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x4b, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x6f, 0x70,
+	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x32, 0x0a, 0x0b,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x22, 0xde, 0x01, 0x0a, 0x0a, 0x44, 0x75, 0x6d, 0x70, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x22, 0x7d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44,
+	0x75, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x12,
+	0x32, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x55, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d,
+	0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x50, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x0a, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2a, 0xa2, 0x01,
+	0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x41,
+	0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x41, 0x53,
+	0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x35, 0x30, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x39, 0x35, 0x10, 0x05, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x39, 0x39,
+	0x10, 0x06, 0x32, 0xe5, 0x02, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x44, 0x75, 0x6d, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x44, 0x75, 0x6d, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f,
+	0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1079,45 +3338,92 @@ func file_api_api_proto_rawDescGZIP() []byte {
 	return file_api_api_proto_rawDescData
 }
 
-var file_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_api_api_proto_goTypes = []interface{}{
-	(*SystemDump)(nil),            // 0: api.SystemDump
-	(*LoadAverage)(nil),           // 1: api.LoadAverage
-	(*LoadCPU)(nil),               // 2: api.LoadCPU
-	(*DiskStats)(nil),             // 3: api.DiskStats
-	(*LoadDisk)(nil),              // 4: api.LoadDisk
-	(*DiskUsage)(nil),             // 5: api.DiskUsage
-	(*TopTalkers)(nil),            // 6: api.TopTalkers
-	(*ConnectStats)(nil),          // 7: api.ConnectStats
-	(*TopTalkersProtocol)(nil),    // 8: api.TopTalkersProtocol
-	(*TopTalkersTraffic)(nil),     // 9: api.TopTalkersTraffic
-	(*ListeningSocket)(nil),       // 10: api.ListeningSocket
-	(*Connect)(nil),               // 11: api.Connect
-	(*GetSystemDumpRequest)(nil),  // 12: api.GetSystemDumpRequest
-	(*GetSystemDumpResponse)(nil), // 13: api.GetSystemDumpResponse
+	(Aggregation)(0),                     // 0: api.Aggregation
+	(*SystemDump)(nil),                   // 1: api.SystemDump
+	(*LoadAverage)(nil),                  // 2: api.LoadAverage
+	(*LoadCPU)(nil),                      // 3: api.LoadCPU
+	(*LoadCore)(nil),                     // 4: api.LoadCore
+	(*DiskStats)(nil),                    // 5: api.DiskStats
+	(*LoadDisk)(nil),                     // 6: api.LoadDisk
+	(*DiskUsage)(nil),                    // 7: api.DiskUsage
+	(*MemoryStats)(nil),                  // 8: api.MemoryStats
+	(*Pressure)(nil),                     // 9: api.Pressure
+	(*PressureResource)(nil),             // 10: api.PressureResource
+	(*PressureLine)(nil),                 // 11: api.PressureLine
+	(*InterfaceStats)(nil),               // 12: api.InterfaceStats
+	(*TopTalkers)(nil),                   // 13: api.TopTalkers
+	(*ConnectStats)(nil),                 // 14: api.ConnectStats
+	(*ProtocolCounters)(nil),             // 15: api.ProtocolCounters
+	(*TopProcesses)(nil),                 // 16: api.TopProcesses
+	(*Process)(nil),                      // 17: api.Process
+	(*CgroupStats)(nil),                  // 18: api.CgroupStats
+	(*CgroupIO)(nil),                     // 19: api.CgroupIO
+	(*CollectorStatus)(nil),              // 20: api.CollectorStatus
+	(*CustomMetric)(nil),                 // 21: api.CustomMetric
+	(*TopTalkersProtocol)(nil),           // 22: api.TopTalkersProtocol
+	(*TopTalkersTraffic)(nil),            // 23: api.TopTalkersTraffic
+	(*ListeningSocket)(nil),              // 24: api.ListeningSocket
+	(*Connect)(nil),                      // 25: api.Connect
+	(*GetSystemDumpRequest)(nil),         // 26: api.GetSystemDumpRequest
+	(*DumpFilter)(nil),                   // 27: api.DumpFilter
+	(*GetSystemDumpResponse)(nil),        // 28: api.GetSystemDumpResponse
+	(*GetCollectorStatusRequest)(nil),    // 29: api.GetCollectorStatusRequest
+	(*GetSystemDumpHistoryRequest)(nil),  // 30: api.GetSystemDumpHistoryRequest
+	(*GetSystemDumpHistoryResponse)(nil), // 31: api.GetSystemDumpHistoryResponse
+	(*GetCollectorStatusResponse)(nil),   // 32: api.GetCollectorStatusResponse
+	nil,                                  // 33: api.CustomMetric.LabelsEntry
 }
 var file_api_api_proto_depIdxs = []int32{
-	1,  // 0: api.SystemDump.l_a:type_name -> api.LoadAverage
-	2,  // 1: api.SystemDump.l_c:type_name -> api.LoadCPU
-	3,  // 2: api.SystemDump.d_s:type_name -> api.DiskStats
-	4,  // 3: api.SystemDump.l_d:type_name -> api.LoadDisk
-	5,  // 4: api.SystemDump.d_u:type_name -> api.DiskUsage
-	6,  // 5: api.SystemDump.t_t:type_name -> api.TopTalkers
-	7,  // 6: api.SystemDump.c_s:type_name -> api.ConnectStats
-	8,  // 7: api.TopTalkers.ttp:type_name -> api.TopTalkersProtocol
-	9,  // 8: api.TopTalkers.ttt:type_name -> api.TopTalkersTraffic
-	10, // 9: api.ConnectStats.ls:type_name -> api.ListeningSocket
-	11, // 10: api.ConnectStats.conn:type_name -> api.Connect
-	0,  // 11: api.GetSystemDumpResponse.system_dump:type_name -> api.SystemDump
-	12, // 12: api.SystemStatistics.GetSystemDump:input_type -> api.GetSystemDumpRequest
-	12, // 13: api.SystemStatistics.StreamSystemDump:input_type -> api.GetSystemDumpRequest
-	13, // 14: api.SystemStatistics.GetSystemDump:output_type -> api.GetSystemDumpResponse
-	13, // 15: api.SystemStatistics.StreamSystemDump:output_type -> api.GetSystemDumpResponse
-	14, // [14:16] is the sub-list for method output_type
-	12, // [12:14] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 0: api.SystemDump.l_a:type_name -> api.LoadAverage
+	3,  // 1: api.SystemDump.l_c:type_name -> api.LoadCPU
+	5,  // 2: api.SystemDump.d_s:type_name -> api.DiskStats
+	6,  // 3: api.SystemDump.l_d:type_name -> api.LoadDisk
+	7,  // 4: api.SystemDump.d_u:type_name -> api.DiskUsage
+	13, // 5: api.SystemDump.t_t:type_name -> api.TopTalkers
+	14, // 6: api.SystemDump.c_s:type_name -> api.ConnectStats
+	8,  // 7: api.SystemDump.m_s:type_name -> api.MemoryStats
+	9,  // 8: api.SystemDump.p_s_i:type_name -> api.Pressure
+	12, // 9: api.SystemDump.i_s:type_name -> api.InterfaceStats
+	15, // 10: api.SystemDump.p_c:type_name -> api.ProtocolCounters
+	16, // 11: api.SystemDump.t_p:type_name -> api.TopProcesses
+	18, // 12: api.SystemDump.c_g:type_name -> api.CgroupStats
+	20, // 13: api.SystemDump.c_st:type_name -> api.CollectorStatus
+	21, // 14: api.SystemDump.c_m:type_name -> api.CustomMetric
+	4,  // 15: api.LoadCPU.cores:type_name -> api.LoadCore
+	10, // 16: api.Pressure.cpu:type_name -> api.PressureResource
+	10, // 17: api.Pressure.memory:type_name -> api.PressureResource
+	10, // 18: api.Pressure.io:type_name -> api.PressureResource
+	11, // 19: api.PressureResource.some:type_name -> api.PressureLine
+	11, // 20: api.PressureResource.full:type_name -> api.PressureLine
+	22, // 21: api.TopTalkers.ttp:type_name -> api.TopTalkersProtocol
+	23, // 22: api.TopTalkers.ttt:type_name -> api.TopTalkersTraffic
+	24, // 23: api.ConnectStats.ls:type_name -> api.ListeningSocket
+	25, // 24: api.ConnectStats.conn:type_name -> api.Connect
+	17, // 25: api.TopProcesses.processes:type_name -> api.Process
+	19, // 26: api.CgroupStats.io:type_name -> api.CgroupIO
+	33, // 27: api.CustomMetric.labels:type_name -> api.CustomMetric.LabelsEntry
+	0,  // 28: api.GetSystemDumpRequest.aggregation:type_name -> api.Aggregation
+	27, // 29: api.GetSystemDumpRequest.filter:type_name -> api.DumpFilter
+	1,  // 30: api.GetSystemDumpResponse.system_dump:type_name -> api.SystemDump
+	0,  // 31: api.GetSystemDumpResponse.aggregation:type_name -> api.Aggregation
+	1,  // 32: api.GetSystemDumpHistoryResponse.system_dump:type_name -> api.SystemDump
+	20, // 33: api.GetCollectorStatusResponse.collectors:type_name -> api.CollectorStatus
+	26, // 34: api.SystemStatistics.GetSystemDump:input_type -> api.GetSystemDumpRequest
+	26, // 35: api.SystemStatistics.StreamSystemDump:input_type -> api.GetSystemDumpRequest
+	29, // 36: api.SystemStatistics.GetCollectorStatus:input_type -> api.GetCollectorStatusRequest
+	30, // 37: api.SystemStatistics.GetSystemDumpHistory:input_type -> api.GetSystemDumpHistoryRequest
+	28, // 38: api.SystemStatistics.GetSystemDump:output_type -> api.GetSystemDumpResponse
+	28, // 39: api.SystemStatistics.StreamSystemDump:output_type -> api.GetSystemDumpResponse
+	32, // 40: api.SystemStatistics.GetCollectorStatus:output_type -> api.GetCollectorStatusResponse
+	31, // 41: api.SystemStatistics.GetSystemDumpHistory:output_type -> api.GetSystemDumpHistoryResponse
+	38, // [38:42] is the sub-list for method output_type
+	34, // [34:38] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_api_api_proto_init() }
@@ -1163,7 +3469,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskStats); i {
+			switch v := v.(*LoadCore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1175,7 +3481,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadDisk); i {
+			switch v := v.(*DiskStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1187,7 +3493,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskUsage); i {
+			switch v := v.(*LoadDisk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1199,7 +3505,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopTalkers); i {
+			switch v := v.(*DiskUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1211,7 +3517,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectStats); i {
+			switch v := v.(*MemoryStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1223,7 +3529,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopTalkersProtocol); i {
+			switch v := v.(*Pressure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1235,7 +3541,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopTalkersTraffic); i {
+			switch v := v.(*PressureResource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1247,7 +3553,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListeningSocket); i {
+			switch v := v.(*PressureLine); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1259,7 +3565,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Connect); i {
+			switch v := v.(*InterfaceStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1271,7 +3577,7 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystemDumpRequest); i {
+			switch v := v.(*TopTalkers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1283,6 +3589,174 @@ func file_api_api_proto_init() {
 			}
 		}
 		file_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolCounters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopProcesses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Process); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupIO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopTalkersProtocol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopTalkersTraffic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListeningSocket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Connect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemDumpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSystemDumpResponse); i {
 			case 0:
 				return &v.state
@@ -1294,19 +3768,68 @@ func file_api_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectorStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemDumpHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemDumpHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectorStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_api_proto_goTypes,
 		DependencyIndexes: file_api_api_proto_depIdxs,
+		EnumInfos:         file_api_api_proto_enumTypes,
 		MessageInfos:      file_api_api_proto_msgTypes,
 	}.Build()
 	File_api_api_proto = out.File
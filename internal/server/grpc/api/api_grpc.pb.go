@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SystemStatistics_GetSystemDump_FullMethodName    = "/api.SystemStatistics/GetSystemDump"
-	SystemStatistics_StreamSystemDump_FullMethodName = "/api.SystemStatistics/StreamSystemDump"
+	SystemStatistics_GetSystemDump_FullMethodName        = "/api.SystemStatistics/GetSystemDump"
+	SystemStatistics_StreamSystemDump_FullMethodName     = "/api.SystemStatistics/StreamSystemDump"
+	SystemStatistics_GetCollectorStatus_FullMethodName   = "/api.SystemStatistics/GetCollectorStatus"
+	SystemStatistics_GetSystemDumpHistory_FullMethodName = "/api.SystemStatistics/GetSystemDumpHistory"
 )
 
 // SystemStatisticsClient is the client API for SystemStatistics service.
@@ -29,6 +31,8 @@ const (
 type SystemStatisticsClient interface {
 	GetSystemDump(ctx context.Context, in *GetSystemDumpRequest, opts ...grpc.CallOption) (*GetSystemDumpResponse, error)
 	StreamSystemDump(ctx context.Context, in *GetSystemDumpRequest, opts ...grpc.CallOption) (SystemStatistics_StreamSystemDumpClient, error)
+	GetCollectorStatus(ctx context.Context, in *GetCollectorStatusRequest, opts ...grpc.CallOption) (*GetCollectorStatusResponse, error)
+	GetSystemDumpHistory(ctx context.Context, in *GetSystemDumpHistoryRequest, opts ...grpc.CallOption) (SystemStatistics_GetSystemDumpHistoryClient, error)
 }
 
 type systemStatisticsClient struct {
@@ -80,12 +84,55 @@ func (x *systemStatisticsStreamSystemDumpClient) Recv() (*GetSystemDumpResponse,
 	return m, nil
 }
 
+func (c *systemStatisticsClient) GetCollectorStatus(ctx context.Context, in *GetCollectorStatusRequest, opts ...grpc.CallOption) (*GetCollectorStatusResponse, error) {
+	out := new(GetCollectorStatusResponse)
+	err := c.cc.Invoke(ctx, SystemStatistics_GetCollectorStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemStatisticsClient) GetSystemDumpHistory(ctx context.Context, in *GetSystemDumpHistoryRequest, opts ...grpc.CallOption) (SystemStatistics_GetSystemDumpHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SystemStatistics_ServiceDesc.Streams[1], SystemStatistics_GetSystemDumpHistory_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemStatisticsGetSystemDumpHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SystemStatistics_GetSystemDumpHistoryClient interface {
+	Recv() (*GetSystemDumpHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type systemStatisticsGetSystemDumpHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemStatisticsGetSystemDumpHistoryClient) Recv() (*GetSystemDumpHistoryResponse, error) {
+	m := new(GetSystemDumpHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SystemStatisticsServer is the server API for SystemStatistics service.
 // All implementations must embed UnimplementedSystemStatisticsServer
 // for forward compatibility
 type SystemStatisticsServer interface {
 	GetSystemDump(context.Context, *GetSystemDumpRequest) (*GetSystemDumpResponse, error)
 	StreamSystemDump(*GetSystemDumpRequest, SystemStatistics_StreamSystemDumpServer) error
+	GetCollectorStatus(context.Context, *GetCollectorStatusRequest) (*GetCollectorStatusResponse, error)
+	GetSystemDumpHistory(*GetSystemDumpHistoryRequest, SystemStatistics_GetSystemDumpHistoryServer) error
 	mustEmbedUnimplementedSystemStatisticsServer()
 }
 
@@ -99,6 +146,12 @@ func (UnimplementedSystemStatisticsServer) GetSystemDump(context.Context, *GetSy
 func (UnimplementedSystemStatisticsServer) StreamSystemDump(*GetSystemDumpRequest, SystemStatistics_StreamSystemDumpServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamSystemDump not implemented")
 }
+func (UnimplementedSystemStatisticsServer) GetCollectorStatus(context.Context, *GetCollectorStatusRequest) (*GetCollectorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectorStatus not implemented")
+}
+func (UnimplementedSystemStatisticsServer) GetSystemDumpHistory(*GetSystemDumpHistoryRequest, SystemStatistics_GetSystemDumpHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSystemDumpHistory not implemented")
+}
 func (UnimplementedSystemStatisticsServer) mustEmbedUnimplementedSystemStatisticsServer() {}
 
 // UnsafeSystemStatisticsServer may be embedded to opt out of forward compatibility for this service.
@@ -151,6 +204,45 @@ func (x *systemStatisticsStreamSystemDumpServer) Send(m *GetSystemDumpResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _SystemStatistics_GetCollectorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemStatisticsServer).GetCollectorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemStatistics_GetCollectorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemStatisticsServer).GetCollectorStatus(ctx, req.(*GetCollectorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemStatistics_GetSystemDumpHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSystemDumpHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemStatisticsServer).GetSystemDumpHistory(m, &systemStatisticsGetSystemDumpHistoryServer{stream})
+}
+
+type SystemStatistics_GetSystemDumpHistoryServer interface {
+	Send(*GetSystemDumpHistoryResponse) error
+	grpc.ServerStream
+}
+
+type systemStatisticsGetSystemDumpHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemStatisticsGetSystemDumpHistoryServer) Send(m *GetSystemDumpHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SystemStatistics_ServiceDesc is the grpc.ServiceDesc for SystemStatistics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,6 +254,10 @@ var SystemStatistics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSystemDump",
 			Handler:    _SystemStatistics_GetSystemDump_Handler,
 		},
+		{
+			MethodName: "GetCollectorStatus",
+			Handler:    _SystemStatistics_GetCollectorStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -169,6 +265,11 @@ var SystemStatistics_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SystemStatistics_StreamSystemDump_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetSystemDumpHistory",
+			Handler:       _SystemStatistics_GetSystemDumpHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/api.proto",
 }
@@ -3,15 +3,23 @@ package validate
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
+	"github.com/lixoi/system_stats_daemon/config"
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxHistoryPoints limits the points of a history stream, a snapshot is
+// taken every second, so a range over an hour needs a step.
+const maxHistoryPoints = 3600
+
 type Validator func(req interface{}) error
 
 type recvValidator struct {
@@ -20,23 +28,24 @@ type recvValidator struct {
 	info      *grpc.StreamServerInfo
 }
 
+// RecvMsg validates the received message.
 func (s *recvValidator) RecvMsg(m interface{}) error {
-	if err := s.validFunc(m); err != nil {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "validate.go",
 			"func": "RecvMsg()",
 		}).Error(err.Error())
-		return status.Errorf(
-			codes.InvalidArgument,
-			"%s is rejected by validate. Error: %v",
-			s.info.FullMethod, err)
+		return err
 	}
-	if err := s.ServerStream.RecvMsg(m); err != nil {
+	if err := s.validFunc(m); err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "validate.go",
 			"func": "RecvMsg()",
 		}).Error(err.Error())
-		return err
+		return status.Errorf(
+			codes.InvalidArgument,
+			"%s is rejected by validate. Error: %v",
+			s.info.FullMethod, err)
 	}
 	return nil
 }
@@ -90,6 +99,49 @@ func Req(req interface{}) error {
 			}).Error(err)
 			return errors.New(err)
 		}
+		if r.GetTopProcessesSortBy() != "" && !slices.Contains(config.ProcessSortKeys, r.GetTopProcessesSortBy()) {
+			err := "there is not current parameter TopProcessesSortBy"
+			logger.Log.WithFields(logrus.Fields{
+				"file": "validate.go",
+				"func": "Req()",
+			}).Error(err)
+			return errors.New(err)
+		}
+		if r.GetSortBy() != "" && !slices.Contains(config.SortKeys, r.GetSortBy()) {
+			err := "there is not current parameter SortBy"
+			logger.Log.WithFields(logrus.Fields{
+				"file": "validate.go",
+				"func": "Req()",
+			}).Error(err)
+			return errors.New(err)
+		}
+		if _, ok := api.Aggregation_name[int32(r.GetAggregation())]; !ok {
+			err := "there is not current parameter Aggregation"
+			logger.Log.WithFields(logrus.Fields{
+				"file": "validate.go",
+				"func": "Req()",
+			}).Error(err)
+			return errors.New(err)
+		}
+	case *api.GetSystemDumpHistoryRequest:
+		if r.GetFrom() <= 0 || (r.GetTo() != 0 && r.GetTo() < r.GetFrom()) {
+			err := "there are not current parameters From and To"
+			logger.Log.WithFields(logrus.Fields{
+				"file": "validate.go",
+				"func": "Req()",
+			}).Error(err)
+			return errors.New(err)
+		}
+		if historyPoints(r) > maxHistoryPoints {
+			err := "there are more than " + strconv.Itoa(maxHistoryPoints) + " points, parameter Step is too small"
+			logger.Log.WithFields(logrus.Fields{
+				"file": "validate.go",
+				"func": "Req()",
+			}).Error(err)
+			return errors.New(err)
+		}
+	case *api.GetCollectorStatusRequest:
+		// has no parameters
 	default:
 		logger.Log.WithFields(logrus.Fields{
 			"file": "validate.go",
@@ -98,3 +150,18 @@ func Req(req interface{}) error {
 	}
 	return nil
 }
+
+// historyPoints returns the number of points of the history request,
+// one per second without a step.
+func historyPoints(r *api.GetSystemDumpHistoryRequest) int64 {
+	to := time.Now().UnixNano()
+	if r.GetTo() > 0 {
+		to = r.GetTo()
+	}
+	step := int64(time.Second)
+	if r.GetStep() > 0 {
+		step *= int64(r.GetStep())
+	}
+
+	return (to - r.GetFrom()) / step
+}
@@ -0,0 +1,80 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// recvStream receives req or fails with err.
+type recvStream struct {
+	grpc.ServerStream
+	req proto.Message
+	err error
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+	proto.Merge(m.(proto.Message), s.req)
+
+	return nil
+}
+
+func TestStreamServerRequestValidatorInterceptor(t *testing.T) {
+	logger.Init("Debug")
+	interceptor := StreamServerRequestValidatorInterceptor(Req)
+	info := &grpc.StreamServerInfo{FullMethod: "/api.SystemStatistics/GetSystemDumpHistory"}
+	recv := func(stream grpc.ServerStream) error {
+		return interceptor(nil, stream, info, func(_ interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(&api.GetSystemDumpHistoryRequest{})
+		})
+	}
+
+	t.Run("received message is validated", func(t *testing.T) {
+		// an empty history request is invalid, the received one is valid
+		from := time.Now().Add(-time.Minute).UnixNano()
+		require.NoError(t, recv(&recvStream{req: &api.GetSystemDumpHistoryRequest{From: from}}))
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		err := recv(&recvStream{req: &api.GetSystemDumpHistoryRequest{From: 10, To: 5}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("receive error", func(t *testing.T) {
+		errRecv := errors.New("connection reset")
+		require.Equal(t, errRecv, recv(&recvStream{err: errRecv}))
+	})
+}
+
+func TestReqHistory(t *testing.T) {
+	logger.Init("Debug")
+	now := time.Now()
+	ago := func(d time.Duration) int64 { return now.Add(-d).UnixNano() }
+
+	t.Run("from and to", func(t *testing.T) {
+		require.Error(t, Req(&api.GetSystemDumpHistoryRequest{}))
+		require.Error(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(time.Minute), To: ago(time.Hour)}))
+		require.NoError(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(time.Minute)}))
+	})
+
+	t.Run("points", func(t *testing.T) {
+		require.NoError(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(59 * time.Minute)}))
+		require.EqualError(t, Req(&api.GetSystemDumpHistoryRequest{From: 1}),
+			"there are more than 3600 points, parameter Step is too small")
+		require.Error(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(2 * time.Hour)}))
+		require.NoError(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(2 * time.Hour), Step: 10}))
+		require.NoError(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(48 * time.Hour), To: ago(47 * time.Hour)}))
+		require.NoError(t, Req(&api.GetSystemDumpHistoryRequest{From: ago(30 * 24 * time.Hour), Step: 3600}))
+	})
+}
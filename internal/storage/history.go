@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+// Aggregate averages the dumps ordered from the oldest to the newest.
+type Aggregate func(dumps []*api.SystemDump) *api.SystemDump
+
+// History is the storage of the snapshots with their rollups: averages over
+// fixed intervals (10s, 1m, 5m) kept longer than the snapshots.
+type History struct {
+	raw       *Storage
+	tiers     []*tier // from the finest resolution
+	aggregate Aggregate
+}
+
+// tier is a storage of rollups of one resolution.
+type tier struct {
+	mu         sync.Mutex
+	resolution time.Duration
+	store      *Storage
+	bucket     time.Time // start of the interval being collected
+	pending    []*api.SystemDump
+}
+
+// OpenHistory opens the storage of the snapshots in the directory and
+// the storages of rollups in its subdirectories named by the resolution
+// in seconds ("10s", "60s").
+func OpenHistory(conf config.StorageConfig, aggregate Aggregate) (*History, error) {
+	raw, err := Open(conf)
+	if err != nil {
+		return nil, err
+	}
+	h := &History{raw: raw, aggregate: aggregate}
+	for _, r := range conf.Rollups {
+		store, err := Open(config.StorageConfig{
+			Directory:   filepath.Join(conf.Directory, strconv.Itoa(int(r.Resolution/time.Second))+"s"),
+			Retention:   r.Retention,
+			MaxSize:     r.MaxSize,
+			SegmentSize: conf.SegmentSize,
+		})
+		if err != nil {
+			h.Close()
+			return nil, err
+		}
+		h.tiers = append(h.tiers, &tier{resolution: r.Resolution, store: store})
+	}
+
+	return h, nil
+}
+
+// Append writes the snapshot and the rollups of the intervals it ends.
+// The interval being collected after a restart is continued with the stored
+// snapshots.
+func (h *History) Append(ts time.Time, dump *api.SystemDump) error {
+	if err := h.raw.Append(ts, dump); err != nil {
+		return err
+	}
+	for _, t := range h.tiers {
+		if err := h.appendRollup(t, ts, dump); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *History) appendRollup(t *tier, ts time.Time, dump *api.SystemDump) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	bucket := ts.Truncate(t.resolution)
+	if t.bucket.IsZero() {
+		t.bucket = bucket
+		err := h.raw.Range(bucket, ts.Add(-time.Nanosecond), func(_ time.Time, d *api.SystemDump) error {
+			t.pending = append(t.pending, d)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if !bucket.Equal(t.bucket) && len(t.pending) > 0 {
+		rollup := h.aggregate(t.pending)
+		rollup.Timestamp = t.bucket.UnixNano()
+		t.pending = nil
+		if err := t.store.Append(t.bucket, rollup); err != nil {
+			return err
+		}
+	}
+	t.bucket = bucket
+	t.pending = append(t.pending, dump)
+
+	return nil
+}
+
+// Resolution returns the coarsest resolution of rollups not coarser than
+// step, zero if only the snapshots are.
+func (h *History) Resolution(step time.Duration) time.Duration {
+	var res time.Duration
+	for _, t := range h.tiers {
+		if t.resolution <= step {
+			res = t.resolution
+		}
+	}
+
+	return res
+}
+
+// Finest returns the finest resolution of rollups, zero if there are none.
+func (h *History) Finest() time.Duration {
+	if len(h.tiers) == 0 {
+		return 0
+	}
+
+	return h.tiers[0].resolution
+}
+
+// Range calls fn for the snapshots or for the rollups of Resolution(step)
+// with timestamps in [from, to] from the oldest. Rollups are stamped with
+// the start of their interval, the last one averages the snapshots of
+// the interval being collected.
+func (h *History) Range(from, to time.Time, step time.Duration, fn func(ts time.Time, dump *api.SystemDump) error) error {
+	resolution := h.Resolution(step)
+	if resolution == 0 {
+		return h.raw.Range(from, to, fn)
+	}
+	for _, t := range h.tiers {
+		if t.resolution != resolution {
+			continue
+		}
+		if err := t.store.Range(from, to, fn); err != nil {
+			return err
+		}
+		t.mu.Lock()
+		bucket, pending := t.bucket, t.pending
+		t.mu.Unlock()
+		if len(pending) == 0 || bucket.Before(from) || bucket.After(to) {
+			return nil
+		}
+		rollup := h.aggregate(pending)
+		rollup.Timestamp = bucket.UnixNano()
+		return fn(bucket, rollup)
+	}
+
+	return nil
+}
+
+// Close closes the storages, the interval being collected is not written:
+// it is continued with the stored snapshots on the next start.
+func (h *History) Close() error {
+	err := h.raw.Close()
+	for _, t := range h.tiers {
+		if errTier := t.store.Close(); err == nil {
+			err = errTier
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func averageLA(dumps []*api.SystemDump) *api.SystemDump {
+	res := &api.SystemDump{LA: &api.LoadAverage{}}
+	for _, d := range dumps {
+		res.LA.AvgOneMin += d.LA.AvgOneMin / float64(len(dumps))
+	}
+
+	return res
+}
+
+func TestHistory(t *testing.T) {
+	logger.Init("Debug")
+	base := t0.Truncate(5 * time.Minute)
+	conf := config.StorageConfig{
+		Directory:   t.TempDir(),
+		SegmentSize: config.DefaultStorageSegmentSize,
+		Rollups: []config.RollupConfig{
+			{Resolution: 10 * time.Second},
+			{Resolution: time.Minute},
+		},
+	}
+	appendSeconds := func(t *testing.T, h *History, from, to int) {
+		for i := from; i <= to; i++ {
+			ts := base.Add(time.Duration(i) * time.Second)
+			require.NoError(t, h.Append(ts, &api.SystemDump{
+				Timestamp: ts.UnixNano(),
+				LA:        &api.LoadAverage{AvgOneMin: float64(i)},
+			}))
+		}
+	}
+	rollups := func(t *testing.T, h *History, step time.Duration) (res []float64, stamps []time.Duration) {
+		err := h.Range(base, base.Add(time.Hour), step, func(ts time.Time, dump *api.SystemDump) error {
+			require.Equal(t, ts.UnixNano(), dump.Timestamp)
+			res = append(res, dump.LA.AvgOneMin)
+			stamps = append(stamps, ts.Sub(base))
+			return nil
+		})
+		require.NoError(t, err)
+		return res, stamps
+	}
+
+	h, err := OpenHistory(conf, averageLA)
+	require.NoError(t, err)
+
+	t.Run("resolution", func(t *testing.T) {
+		require.Equal(t, time.Duration(0), h.Resolution(5*time.Second))
+		require.Equal(t, 10*time.Second, h.Resolution(30*time.Second))
+		require.Equal(t, time.Minute, h.Resolution(2*time.Hour))
+		require.Equal(t, 10*time.Second, h.Finest())
+	})
+
+	t.Run("rollups", func(t *testing.T) {
+		appendSeconds(t, h, 0, 24)
+
+		values, stamps := rollups(t, h, 10*time.Second)
+		// the last one is the interval being collected
+		require.Equal(t, []float64{4.5, 14.5, 22}, values)
+		require.Equal(t, []time.Duration{0, 10 * time.Second, 20 * time.Second}, stamps)
+
+		values, _ = rollups(t, h, time.Minute)
+		require.Equal(t, []float64{12}, values)
+
+		values, _ = rollups(t, h, 0)
+		require.Len(t, values, 25)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.NoError(t, h.Close())
+		h, err = OpenHistory(conf, averageLA)
+		require.NoError(t, err)
+
+		// the interval is continued with the stored snapshots
+		appendSeconds(t, h, 25, 30)
+		values, _ := rollups(t, h, 10*time.Second)
+		require.Equal(t, []float64{4.5, 14.5, 24.5, 30}, values)
+		require.NoError(t, h.Close())
+	})
+}
@@ -0,0 +1,284 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// A segment is a file of records named by the timestamp of its first record,
+// a record is a header (timestamp in ns, length and crc32 of the payload)
+// followed by the protobuf-encoded dump.
+const (
+	segmentExt = ".wal"
+	headerSize = 16
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+var errCorrupted = errors.New("corrupted record")
+
+type segment struct {
+	path  string
+	first int64 // timestamp in ns of the first record
+	size  int64
+}
+
+// Storage is an append-only history of system dumps in segmented files.
+// Segments are removed as a whole when they are older than the retention
+// or the total size is over the limit, the segment being written is kept.
+type Storage struct {
+	mu       sync.Mutex
+	conf     config.StorageConfig
+	segments []segment
+	active   *os.File // the last segment, nil until the first Append
+	now      func() time.Time
+}
+
+// Open opens the storage in the directory and removes the expired segments.
+// A new segment is started on the first Append, so a torn record at the end
+// of the last segment of a crashed daemon is never written after.
+func Open(conf config.StorageConfig) (*Storage, error) {
+	if err := os.MkdirAll(conf.Directory, 0o755); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(conf.Directory)
+	if err != nil {
+		return nil, err
+	}
+	s := &Storage{conf: conf, now: time.Now}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != segmentExt {
+			continue
+		}
+		first, err := strconv.ParseInt(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		s.segments = append(s.segments, segment{
+			path:  filepath.Join(conf.Directory, name),
+			first: first,
+			size:  info.Size(),
+		})
+	}
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].first < s.segments[j].first
+	})
+	s.applyRetention()
+
+	return s, nil
+}
+
+// Append writes the dump stamped with ts.
+func (s *Storage) Append(ts time.Time, dump *api.SystemDump) error {
+	payload, err := proto.Marshal(dump)
+	if err != nil {
+		return err
+	}
+	rec := make([]byte, headerSize+len(payload))
+	binary.LittleEndian.PutUint64(rec[0:], uint64(ts.UnixNano()))
+	binary.LittleEndian.PutUint32(rec[8:], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(rec[12:], crc32.Checksum(payload, crcTable))
+	copy(rec[headerSize:], payload)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active == nil || s.segments[len(s.segments)-1].size >= s.conf.SegmentSize {
+		if err = s.rotate(ts); err != nil {
+			return err
+		}
+	}
+	n, err := s.active.Write(rec)
+	s.segments[len(s.segments)-1].size += int64(n)
+	s.applyRetention()
+
+	return err
+}
+
+// rotate closes the active segment and starts a new one.
+func (s *Storage) rotate(ts time.Time) error {
+	if s.active != nil {
+		if err := s.closeActive(); err != nil {
+			return err
+		}
+	}
+	first := ts.UnixNano()
+	if len(s.segments) > 0 && s.segments[len(s.segments)-1].first >= first {
+		// names must grow even if the clock went back
+		first = s.segments[len(s.segments)-1].first + 1
+	}
+	fpath := filepath.Join(s.conf.Directory, fmt.Sprintf("%020d%s", first, segmentExt))
+	f, err := os.OpenFile(fpath, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	s.active = f
+	s.segments = append(s.segments, segment{path: fpath, first: first})
+
+	return nil
+}
+
+func (s *Storage) closeActive() error {
+	err := s.active.Sync()
+	if errClose := s.active.Close(); err == nil {
+		err = errClose
+	}
+	s.active = nil
+
+	return err
+}
+
+// applyRetention removes the oldest segments older than the retention or over
+// the size limit. A segment has expired when the next one starts before
+// the retention.
+func (s *Storage) applyRetention() {
+	var total int64
+	for _, seg := range s.segments {
+		total += seg.size
+	}
+	expired := s.now().Add(-s.conf.Retention).UnixNano()
+	removed := 0
+	for ; removed < len(s.segments)-1; removed++ {
+		if !(s.conf.Retention > 0 && s.segments[removed+1].first <= expired) &&
+			!(s.conf.MaxSize > 0 && total > s.conf.MaxSize) {
+			break
+		}
+		if err := os.Remove(s.segments[removed].path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "storage.go",
+				"func": "applyRetention()",
+			}).Error(err.Error())
+			break
+		}
+		total -= s.segments[removed].size
+	}
+	s.segments = s.segments[removed:]
+}
+
+// Range calls fn for the dumps with timestamps in [from, to] in the order they
+// were written. Reading a segment stops at its first corrupted record.
+func (s *Storage) Range(from, to time.Time, fn func(ts time.Time, dump *api.SystemDump) error) error {
+	s.mu.Lock()
+	segments := make([]segment, len(s.segments))
+	copy(segments, s.segments)
+	s.mu.Unlock()
+
+	for i, seg := range segments {
+		if seg.first > to.UnixNano() {
+			break
+		}
+		if i+1 < len(segments) && segments[i+1].first < from.UnixNano() {
+			continue
+		}
+		err := readSegment(seg, from.UnixNano(), to.UnixNano(), fn)
+		if errors.Is(err, errCorrupted) {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "storage.go",
+				"func": "Range()",
+			}).Warning(seg.path + ": " + err.Error())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSegment reads the records written up to the size of seg, the active
+// segment may grow meanwhile.
+func readSegment(seg segment, from, to int64, fn func(ts time.Time, dump *api.SystemDump) error) error {
+	f, err := os.Open(seg.path)
+	if errors.Is(err, os.ErrNotExist) {
+		// removed by the retention
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := io.LimitReader(f, seg.size)
+	header := make([]byte, headerSize)
+	var payload []byte
+	for {
+		if _, err = io.ReadFull(r, header); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("%w: %s", errCorrupted, err.Error())
+		}
+		ts := int64(binary.LittleEndian.Uint64(header[0:]))
+		size := binary.LittleEndian.Uint32(header[8:])
+		if int64(size) > seg.size {
+			return fmt.Errorf("%w: length %d", errCorrupted, size)
+		}
+		if cap(payload) < int(size) {
+			payload = make([]byte, size)
+		}
+		payload = payload[:size]
+		if _, err = io.ReadFull(r, payload); err != nil {
+			return fmt.Errorf("%w: %s", errCorrupted, err.Error())
+		}
+		if ts < from || ts > to {
+			continue
+		}
+		if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[12:]) {
+			return fmt.Errorf("%w: checksum mismatch", errCorrupted)
+		}
+		dump := &api.SystemDump{}
+		if err = proto.Unmarshal(payload, dump); err != nil {
+			return fmt.Errorf("%w: %s", errCorrupted, err.Error())
+		}
+		if err = fn(time.Unix(0, ts), dump); err != nil {
+			return err
+		}
+	}
+}
+
+// Size returns the total size of the segments in bytes.
+func (s *Storage) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var total int64
+	for _, seg := range s.segments {
+		total += seg.size
+	}
+
+	return total
+}
+
+// Close syncs and closes the active segment.
+func (s *Storage) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active == nil {
+		return nil
+	}
+
+	return s.closeActive()
+}
@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var t0 = time.Unix(1700000000, 0)
+
+func at(sec int) time.Time {
+	return t0.Add(time.Duration(sec) * time.Second)
+}
+
+func dumpAt(sec int) *api.SystemDump {
+	return &api.SystemDump{
+		Timestamp: at(sec).UnixNano(),
+		LA:        &api.LoadAverage{AvgOneMin: float64(sec)},
+	}
+}
+
+// load returns AvgOneMin of the stored dumps in [from, to].
+func load(t *testing.T, s *Storage, from, to int) []float64 {
+	t.Helper()
+	res := make([]float64, 0)
+	err := s.Range(at(from), at(to), func(ts time.Time, dump *api.SystemDump) error {
+		require.Equal(t, ts.UnixNano(), dump.Timestamp)
+		res = append(res, dump.LA.AvgOneMin)
+		return nil
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+func segments(t *testing.T, dir string) int {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	return len(entries)
+}
+
+func TestStorage(t *testing.T) {
+	logger.Init("Debug")
+	recordSize := int64(headerSize + len(mustMarshal(t, dumpAt(1))))
+
+	t.Run("append and range", func(t *testing.T) {
+		conf := config.StorageConfig{Directory: t.TempDir(), SegmentSize: 3 * recordSize}
+		s, err := Open(conf)
+		require.NoError(t, err)
+		for i := 1; i <= 10; i++ {
+			require.NoError(t, s.Append(at(i), dumpAt(i)))
+		}
+
+		require.Equal(t, 4, segments(t, conf.Directory))
+		require.Equal(t, []float64{4, 5, 6, 7}, load(t, s, 4, 7))
+		require.Equal(t, []float64{1, 2}, load(t, s, -10, 2))
+		require.Empty(t, load(t, s, 11, 20))
+		require.NoError(t, s.Close())
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		conf := config.StorageConfig{Directory: t.TempDir(), SegmentSize: 100 * recordSize}
+		s, err := Open(conf)
+		require.NoError(t, err)
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, s.Append(at(i), dumpAt(i)))
+		}
+		require.NoError(t, s.Close())
+
+		s, err = Open(conf)
+		require.NoError(t, err)
+		require.Equal(t, []float64{1, 2, 3}, load(t, s, 0, 10))
+		// a new segment is started after the restart
+		require.NoError(t, s.Append(at(4), dumpAt(4)))
+		require.Equal(t, 2, segments(t, conf.Directory))
+		require.Equal(t, []float64{1, 2, 3, 4}, load(t, s, 0, 10))
+		require.NoError(t, s.Close())
+	})
+
+	t.Run("retention by size", func(t *testing.T) {
+		conf := config.StorageConfig{
+			Directory:   t.TempDir(),
+			SegmentSize: 2 * recordSize,
+			MaxSize:     5 * recordSize,
+		}
+		s, err := Open(conf)
+		require.NoError(t, err)
+		for i := 1; i <= 10; i++ {
+			require.NoError(t, s.Append(at(i), dumpAt(i)))
+		}
+
+		require.LessOrEqual(t, s.Size(), conf.MaxSize)
+		require.Equal(t, []float64{7, 8, 9, 10}, load(t, s, 0, 10))
+		require.NoError(t, s.Close())
+	})
+
+	t.Run("retention by age", func(t *testing.T) {
+		conf := config.StorageConfig{
+			Directory:   t.TempDir(),
+			SegmentSize: 2 * recordSize,
+			Retention:   4 * time.Second,
+		}
+		s, err := Open(conf)
+		require.NoError(t, err)
+		for i := 1; i <= 10; i++ {
+			s.now = func() time.Time { return at(i) }
+			require.NoError(t, s.Append(at(i), dumpAt(i)))
+		}
+		// whole segments are removed, so some records are kept a bit longer
+		require.Equal(t, []float64{5, 6, 7, 8, 9, 10}, load(t, s, 0, 10))
+		require.NoError(t, s.Close())
+
+		// everything but the last segment is expired on the next start
+		s, err = Open(conf)
+		require.NoError(t, err)
+		require.Equal(t, []float64{9, 10}, load(t, s, 0, 10))
+	})
+
+	t.Run("torn record", func(t *testing.T) {
+		conf := config.StorageConfig{Directory: t.TempDir(), SegmentSize: 100 * recordSize}
+		s, err := Open(conf)
+		require.NoError(t, err)
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, s.Append(at(i), dumpAt(i)))
+		}
+		require.NoError(t, s.Close())
+		fpath := s.segments[0].path
+		require.NoError(t, os.Truncate(fpath, 3*recordSize-5))
+
+		s, err = Open(conf)
+		require.NoError(t, err)
+		require.Equal(t, []float64{1, 2}, load(t, s, 0, 10))
+		require.NoError(t, s.Append(at(4), dumpAt(4)))
+		require.Equal(t, []float64{1, 2, 4}, load(t, s, 0, 10))
+		require.NoError(t, s.Close())
+	})
+}
+
+func mustMarshal(t *testing.T, dump *api.SystemDump) []byte {
+	t.Helper()
+	data, err := proto.Marshal(dump)
+	require.NoError(t, err)
+
+	return data
+}
@@ -0,0 +1,265 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// cgroupSample are the counters of a cgroup needed to compute rates,
+// io keys are "<major:minor>.<rbytes|wbytes|rios|wios>".
+type cgroupSample struct {
+	CPU map[string]uint64
+	IO  map[string]uint64
+}
+
+// cgroupSampler keeps the previous per-cgroup samples to compute rates.
+type cgroupSampler struct {
+	mu   sync.Mutex
+	prev map[string]cgroupSample
+	ts   time.Time
+}
+
+var cgroupStatsSampler = &cgroupSampler{}
+
+// GetCgroupStats returns cgroup v2 statistics of every cgroup down to conf.Depth
+// (the root cgroup is not reported). Rates are computed since the previous call
+// and are zero on the first one.
+func GetCgroupStats(conf config.CgroupsConfig) ([]*api.CgroupStats, error) {
+	root, err := findCgroup2Root(sysPath("fs", "cgroup"))
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "cgroup_stats_linux.go",
+			"func": "GetCgroupStats()",
+		}).Error(err.Error())
+		return nil, err
+	}
+	paths, err := walkCgroups(root, conf)
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "cgroup_stats_linux.go",
+			"func": "GetCgroupStats()",
+		}).Error(err.Error())
+		return nil, err
+	}
+	now := time.Now()
+
+	cgroupStatsSampler.mu.Lock()
+	prev, seconds := cgroupStatsSampler.prev, now.Sub(cgroupStatsSampler.ts).Seconds()
+	cgroupStatsSampler.mu.Unlock()
+
+	cur := make(map[string]cgroupSample, len(paths))
+	res := make([]*api.CgroupStats, 0, len(paths))
+	devices := make(map[string]string, 5)
+	for _, p := range paths {
+		cs, sample := readCgroup(filepath.Join(root, p))
+		cs.Path = p
+		cur[p] = sample
+		ps, ok := prev[p]
+		for _, key := range sortedKeys(sample.IO, ".rbytes") {
+			dev := strings.TrimSuffix(key, ".rbytes")
+			if _, ok := devices[dev]; !ok {
+				devices[dev] = blockDeviceName(dev)
+			}
+			cio := &api.CgroupIO{Device: devices[dev]}
+			if ok && seconds > 0 {
+				rate := func(counter string) float64 {
+					return float64(counterDelta(sample.IO[dev+counter], ps.IO[dev+counter])) / seconds
+				}
+				cio.Rbps, cio.Wbps = rate(".rbytes"), rate(".wbytes")
+				cio.Rios, cio.Wios = rate(".rios"), rate(".wios")
+			}
+			cs.Io = append(cs.Io, cio)
+		}
+		if ok && seconds > 0 {
+			rate := func(counter string) float64 {
+				return float64(counterDelta(sample.CPU[counter], ps.CPU[counter])) / seconds
+			}
+			// usec per second to % of one CPU
+			cs.CpuUsage = rate("usage_usec") / 1e4
+			cs.CpuUser = rate("user_usec") / 1e4
+			cs.CpuSystem = rate("system_usec") / 1e4
+			cs.NrThrottled = rate("nr_throttled")
+			cs.ThrottledTime = rate("throttled_usec") / 1e3
+		}
+		res = append(res, cs)
+	}
+
+	cgroupStatsSampler.mu.Lock()
+	cgroupStatsSampler.prev, cgroupStatsSampler.ts = cur, now
+	cgroupStatsSampler.mu.Unlock()
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "cgroup_stats_linux.go",
+		"func": "GetCgroupStats()",
+	}).Debug("")
+
+	return res, nil
+}
+
+// findCgroup2Root returns the cgroup2 mount, in the hybrid layout of
+// systemd it is <mount>/unified.
+func findCgroup2Root(mount string) (string, error) {
+	for _, root := range []string{mount, filepath.Join(mount, "unified")} {
+		if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err == nil {
+			return root, nil
+		}
+	}
+
+	return "", errors.New("cgroup v2 is not mounted on " + mount)
+}
+
+// walkCgroups returns the sorted paths (relative to root, starting with "/") of
+// the cgroups down to conf.Depth that pass the include and exclude globs.
+func walkCgroups(root string, conf config.CgroupsConfig) ([]string, error) {
+	res := make([]string, 0, 20)
+	var walk func(rel string, depth int) error
+	walk = func(rel string, depth int) error {
+		if depth > conf.Depth {
+			return nil
+		}
+		entries, err := os.ReadDir(filepath.Join(root, rel))
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			p := path.Join("/", rel, entry.Name())
+			if matchCgroup(p, conf) {
+				res = append(res, p)
+			}
+			// cgroups may vanish while walking
+			if err := walk(p, depth+1); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk("/", 1); err != nil {
+		return nil, err
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
+func matchCgroup(p string, conf config.CgroupsConfig) bool {
+	match := func(globs []string) bool {
+		for _, glob := range globs {
+			if ok, _ := path.Match(glob, p); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(conf.Include) > 0 && !match(conf.Include) {
+		return false
+	}
+
+	return !match(conf.Exclude)
+}
+
+// readCgroup reads the interface files of a cgroup, files of disabled
+// controllers are missing and their fields stay zero.
+func readCgroup(dir string) (*api.CgroupStats, cgroupSample) {
+	cs := &api.CgroupStats{}
+	sample := cgroupSample{IO: make(map[string]uint64, 8)}
+
+	sample.CPU, _ = readKeyValueFile(filepath.Join(dir, "cpu.stat"))
+	if current, err := readCgroupValue(filepath.Join(dir, "memory.current")); err == nil {
+		cs.MemoryCurrent = current / 1024
+	}
+	if max, err := readCgroupValue(filepath.Join(dir, "memory.max")); err == nil {
+		cs.MemoryMax = max / 1024
+	}
+	if events, err := readKeyValueFile(filepath.Join(dir, "memory.events")); err == nil {
+		cs.MemoryOom, cs.MemoryOomKill = events["oom"], events["oom_kill"]
+	}
+	if pids, err := readCgroupValue(filepath.Join(dir, "pids.current")); err == nil {
+		cs.PidsCurrent = pids
+	}
+	_ = readCgroupIOStat(filepath.Join(dir, "io.stat"), sample.IO)
+
+	return cs, sample
+}
+
+// readCgroupValue reads single value files, "max" gives zero.
+func readCgroupValue(fpath string) (uint64, error) {
+	value, err := os.ReadFile(fpath)
+	if err != nil {
+		return 0, err
+	}
+	v := strings.TrimSpace(string(value))
+	if v == "max" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(v, 10, 64)
+}
+
+// readCgroupIOStat parses "8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0".
+func readCgroupIOStat(fpath string, res map[string]uint64) error {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			if value, err := strconv.ParseUint(kv[1], 10, 64); err == nil {
+				res[fields[0]+"."+kv[0]] = value
+			}
+		}
+	}
+
+	return scanner.Err()
+}
+
+// blockDeviceName resolves "8:0" to "sda" through /sys/dev/block.
+func blockDeviceName(dev string) string {
+	link, err := os.Readlink(sysPath("dev", "block", dev))
+	if err != nil {
+		return dev
+	}
+
+	return filepath.Base(link)
+}
+
+func sortedKeys(m map[string]uint64, suffix string) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		if strings.HasSuffix(k, suffix) {
+			res = append(res, k)
+		}
+	}
+	sort.Strings(res)
+
+	return res
+}
@@ -0,0 +1,84 @@
+package sysstats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCgroupFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	require.Nil(t, os.MkdirAll(dir, 0o755))
+	for name, content := range files {
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+}
+
+func TestWalkCgroups(t *testing.T) {
+	root := t.TempDir()
+	writeCgroupFiles(t, root, map[string]string{"cgroup.controllers": "cpu io memory pids\n"})
+	for _, dir := range []string{
+		"system.slice/docker-1.scope", "system.slice/ssh.service/deep", "user.slice/user-1000.slice",
+	} {
+		require.Nil(t, os.MkdirAll(filepath.Join(root, dir), 0o755))
+	}
+
+	t.Run("depth", func(t *testing.T) {
+		paths, err := walkCgroups(root, config.CgroupsConfig{Depth: 1})
+		require.Nil(t, err)
+		require.Equal(t, []string{"/system.slice", "/user.slice"}, paths)
+	})
+
+	t.Run("include and exclude", func(t *testing.T) {
+		paths, err := walkCgroups(root, config.CgroupsConfig{
+			Depth:   3,
+			Include: []string{"/system.slice/*"},
+			Exclude: []string{"/system.slice/*.service"},
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"/system.slice/docker-1.scope"}, paths)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		res, err := findCgroup2Root(root)
+		require.Nil(t, err)
+		require.Equal(t, root, res)
+		_, err = findCgroup2Root(filepath.Join(root, "user.slice"))
+		require.NotNil(t, err)
+	})
+}
+
+func TestReadCgroup(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "docker-1.scope")
+	writeCgroupFiles(t, dir, map[string]string{
+		"cpu.stat": "usage_usec 2000000\nuser_usec 1500000\nsystem_usec 500000\n" +
+			"nr_periods 10\nnr_throttled 3\nthrottled_usec 4000\n",
+		"memory.current": "1048576\n",
+		"memory.max":     "max\n",
+		"memory.events":  "low 0\nhigh 0\nmax 2\noom 1\noom_kill 1\n",
+		"io.stat":        "8:0 rbytes=4096 wbytes=8192 rios=1 wios=2 dbytes=0 dios=0\n",
+		"pids.current":   "7\n",
+	})
+
+	cs, sample := readCgroup(dir)
+	require.Equal(t, uint64(1024), cs.MemoryCurrent)
+	require.Equal(t, uint64(0), cs.MemoryMax)
+	require.Equal(t, uint64(1), cs.MemoryOom)
+	require.Equal(t, uint64(1), cs.MemoryOomKill)
+	require.Equal(t, uint64(7), cs.PidsCurrent)
+	require.Equal(t, uint64(2000000), sample.CPU["usage_usec"])
+	require.Equal(t, uint64(3), sample.CPU["nr_throttled"])
+	require.Equal(t, uint64(8192), sample.IO["8:0.wbytes"])
+	require.Equal(t, uint64(2), sample.IO["8:0.wios"])
+
+	t.Run("disabled controllers", func(t *testing.T) {
+		empty := filepath.Join(t.TempDir(), "init.scope")
+		require.Nil(t, os.MkdirAll(empty, 0o755))
+		cs, sample := readCgroup(empty)
+		require.Equal(t, uint64(0), cs.MemoryCurrent)
+		require.Len(t, sample.IO, 0)
+	})
+}
@@ -0,0 +1,21 @@
+//go:build windows
+
+package sysstats
+
+import (
+	"errors"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+func GetCgroupStats(conf config.CgroupsConfig) ([]*api.CgroupStats, error) {
+	err := "Not release for OS Windows"
+	logger.Log.WithFields(logrus.Fields{
+		"file": "cgroup_stats_windows.go",
+		"func": "GetCgroupStats()",
+	}).Error(err)
+	return nil, errors.New("Not release for OS Windows")
+}
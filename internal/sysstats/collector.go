@@ -0,0 +1,104 @@
+package sysstats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+// Collector fills its section of the system dump.
+type Collector interface {
+	// Name is the name the collector is registered and enabled by.
+	Name() string
+	// Init is called once before the first Collect.
+	Init(conf config.DumpConf) error
+	// Collect writes a new snapshot of the section into dump.
+	Collect(ctx context.Context, dump *api.SystemDump) error
+	// Close releases the resources taken by Init.
+	Close() error
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = make(map[string]func() Collector)
+)
+
+// Register makes a collector available by name, it panics if the name
+// is already taken. It is meant to be called from init functions.
+func Register(name string, factory func() Collector) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[name]; ok {
+		panic("sysstats: collector " + name + " is registered twice")
+	}
+	registry[name] = factory
+}
+
+// NewCollector returns a new instance of the registered collector.
+func NewCollector(name string) (Collector, error) {
+	registryMu.Lock()
+	factory, ok := registry[name]
+	registryMu.Unlock()
+
+	if !ok {
+		// plugins are configured, not registered
+		if strings.HasPrefix(name, config.PluginCollectorPrefix) {
+			return newExecCollector(name), nil
+		}
+		return nil, fmt.Errorf("unknown collector %s", name)
+	}
+
+	return factory(), nil
+}
+
+// Collectors returns the sorted names of the registered collectors.
+func Collectors() []string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	res := make([]string, 0, len(registry))
+	for name := range registry {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// funcCollector adapts the stateless Get functions to Collector,
+// their state (previous samples) lives in the package samplers.
+type funcCollector struct {
+	name    string
+	conf    config.DumpConf
+	collect func(conf config.DumpConf, dump *api.SystemDump) error
+}
+
+func newFuncCollector(name string, collect func(config.DumpConf, *api.SystemDump) error) func() Collector {
+	return func() Collector {
+		return &funcCollector{name: name, collect: collect}
+	}
+}
+
+func (fc *funcCollector) Name() string {
+	return fc.name
+}
+
+func (fc *funcCollector) Init(conf config.DumpConf) error {
+	fc.conf = conf
+
+	return nil
+}
+
+func (fc *funcCollector) Collect(_ context.Context, dump *api.SystemDump) error {
+	return fc.collect(fc.conf, dump)
+}
+
+func (fc *funcCollector) Close() error {
+	return nil
+}
@@ -0,0 +1,55 @@
+package sysstats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCollector struct{}
+
+func (fakeCollector) Name() string               { return "Fake" }
+func (fakeCollector) Init(config.DumpConf) error { return nil }
+func (fakeCollector) Close() error               { return nil }
+func (fakeCollector) Collect(_ context.Context, dump *api.SystemDump) error {
+	dump.Id = "fake"
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("built-in", func(t *testing.T) {
+		conf := config.DumpConf{
+			ConnectStats: true, LoadAverage: true, LoadCPU: true, LoadDisks: true,
+			Memory: true, Pressure: true, InterfaceStats: true, ProtocolCounters: true,
+			DiskStats:         config.DiskStatsConfig{Enable: true},
+			DiskUsage:         config.DiskUsageConfig{Enable: true},
+			TopProcesses:      config.TopProcessesConfig{Enable: true},
+			Cgroups:           config.CgroupsConfig{Enable: true},
+			Textfile:          config.TextfileConfig{Enable: true},
+			NetworkTopTalkers: config.TopTalkersConfig{Enable: true},
+		}
+		names := conf.EnabledCollectors()
+		require.Len(t, names, 14)
+		require.ElementsMatch(t, names, Collectors())
+	})
+
+	t.Run("register", func(t *testing.T) {
+		Register("Fake", func() Collector { return fakeCollector{} })
+		t.Cleanup(func() { delete(registry, "Fake") })
+		require.Panics(t, func() { Register("Fake", func() Collector { return fakeCollector{} }) })
+
+		c, err := NewCollector("Fake")
+		require.Nil(t, err)
+		dump := &api.SystemDump{}
+		require.Nil(t, c.Collect(context.Background(), dump))
+		require.Equal(t, "fake", dump.Id)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := NewCollector("Unknown")
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,116 @@
+package sysstats
+
+import (
+	"context"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+func init() {
+	Register(config.CollectorLoadAverage, newFuncCollector(config.CollectorLoadAverage,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.LA, err = GetLoadAvg()
+			return
+		}))
+	Register(config.CollectorLoadCPU, newFuncCollector(config.CollectorLoadCPU,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.LC, err = GetLoadCPU()
+			return
+		}))
+	Register(config.CollectorDiskStats, newFuncCollector(config.CollectorDiskStats,
+		func(conf config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.DS, err = GetDiskStats(conf.DiskStats)
+			return
+		}))
+	Register(config.CollectorLoadDisks, newFuncCollector(config.CollectorLoadDisks,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.LD, err = GetLoadDisk()
+			return
+		}))
+	Register(config.CollectorDiskUsage, newFuncCollector(config.CollectorDiskUsage,
+		func(conf config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.DU, err = GetDiskUsage(conf.DiskUsage)
+			return
+		}))
+	Register(config.CollectorMemory, newFuncCollector(config.CollectorMemory,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.MS, err = GetMemoryStats()
+			return
+		}))
+	Register(config.CollectorPressure, newFuncCollector(config.CollectorPressure,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.PSI, err = GetPressure()
+			return
+		}))
+	Register(config.CollectorInterfaceStats, newFuncCollector(config.CollectorInterfaceStats,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.IS, err = GetInterfaceStats()
+			return
+		}))
+	Register(config.CollectorConnectStats, newFuncCollector(config.CollectorConnectStats,
+		func(_ config.DumpConf, dump *api.SystemDump) error {
+			var errConn, errLs error
+			dump.CS = &api.ConnectStats{}
+			dump.CS.Conn, errConn = GetConnects()
+			dump.CS.Ls, errLs = GetListeningSockets()
+			SortListeningSockets(dump.CS.Ls, config.SortByPort)
+			if errConn != nil {
+				return errConn
+			}
+			return errLs
+		}))
+	Register(config.CollectorProtocolCounters, newFuncCollector(config.CollectorProtocolCounters,
+		func(_ config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.PC, err = GetProtocolCounters()
+			return
+		}))
+	Register(config.CollectorTopProcesses, newFuncCollector(config.CollectorTopProcesses,
+		func(conf config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.TP, err = GetTopProcesses(conf.TopProcesses)
+			return
+		}))
+	Register(config.CollectorCgroups, newFuncCollector(config.CollectorCgroups,
+		func(conf config.DumpConf, dump *api.SystemDump) (err error) {
+			dump.CG, err = GetCgroupStats(conf.Cgroups)
+			return
+		}))
+	Register(config.CollectorTextfile, func() Collector {
+		return &textfileCollector{}
+	})
+	Register(config.CollectorNetworkTopTalkers, func() Collector {
+		return &topTalkersCollector{}
+	})
+}
+
+// topTalkersCollector owns the packet sniffer of the network top talkers.
+type topTalkersCollector struct {
+	ns       NetworkSniffer
+	interval time.Duration
+}
+
+func (tc *topTalkersCollector) Name() string {
+	return config.CollectorNetworkTopTalkers
+}
+
+func (tc *topTalkersCollector) Init(conf config.DumpConf) error {
+	// packets are kept a bit longer than they are reported over
+	keep, _ := time.ParseDuration("1.5s")
+	tc.interval, _ = time.ParseDuration("1s")
+	tc.ns = NewNetworkSniffer(int(keep.Milliseconds()), keep, conf.NetworkTopTalkers)
+
+	return tc.ns.Start()
+}
+
+func (tc *topTalkersCollector) Collect(_ context.Context, dump *api.SystemDump) (err error) {
+	dump.TT = &api.TopTalkers{}
+	dump.TT.Ttp, dump.TT.Ttt, err = tc.ns.GetNetworkTopTalkers(tc.interval)
+
+	return err
+}
+
+// Close is a no-op: the pcap readers live as long as the daemon.
+func (tc *topTalkersCollector) Close() error {
+	return nil
+}
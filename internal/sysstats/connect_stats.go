@@ -0,0 +1,27 @@
+package sysstats
+
+import (
+	"sort"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+// SortListeningSockets sorts the sockets by pid or port (the default) in
+// descending order, ties are kept in protocol and port order.
+func SortListeningSockets(ls []*api.ListeningSocket, key string) {
+	value := func(s *api.ListeningSocket) uint32 { return s.Port }
+	if key == config.SortByPid {
+		value = func(s *api.ListeningSocket) uint32 { return s.Pid }
+	}
+	sort.SliceStable(ls, func(i, j int) bool {
+		vi, vj := value(ls[i]), value(ls[j])
+		if vi != vj {
+			return vi > vj
+		}
+		if ls[i].Protocol != ls[j].Protocol {
+			return ls[i].Protocol < ls[j].Protocol
+		}
+		return ls[i].Port < ls[j].Port
+	})
+}
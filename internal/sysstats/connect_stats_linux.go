@@ -4,9 +4,9 @@ package sysstats
 
 import (
 	"bufio"
-	"bytes"
 	"errors"
-	"os/exec"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -16,52 +16,37 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+const (
+	tcpListen = "0A"
+	udpClose  = "07"
+)
+
+// tcpStates maps kernel socket states (include/net/tcp_states.h) to ss names.
+var tcpStates = map[string]string{
+	"01": "ESTAB",
+	"02": "SYN-SENT",
+	"03": "SYN-RECV",
+	"04": "FIN-WAIT-1",
+	"05": "FIN-WAIT-2",
+	"06": "TIME-WAIT",
+	"07": "UNCONN",
+	"08": "CLOSE-WAIT",
+	"09": "LAST-ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+	"0C": "SYN-RECV",
+}
+
+type procNetSocket struct {
+	LocalPort  uint32
+	RemotePort uint32
+	State      string
+	UID        string
+	Inode      uint64
+}
+
 func GetListeningSockets() ([]*api.ListeningSocket, error) {
-	// Check if netstat is exists
-	netstat, err := exec.LookPath("netstat")
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "connect_stats_linux.go",
-			"func": "GetListeningSockets()",
-		}).Error(err.Error())
-		return nil, err
-	}
-	out, err := exec.Command(netstat, "-lntup").Output()
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "connect_stats_linux.go",
-			"func": "GetListeningSockets()",
-		}).Error(err.Error())
-		return nil, err
-	}
-	mapPs := make(map[uint32][]*api.ListeningSocket, 20)
-	scanner := bufio.NewScanner(bytes.NewReader(out))
-	scanner.Split(bufio.ScanLines)
-	// Filter the header
-	scanner.Scan()
-	scanner.Scan()
-	for scanner.Scan() {
-		line := scanner.Text()
-		state, err := parserNetStat(line)
-		if err != nil {
-			logger.Log.WithFields(logrus.Fields{
-				"file": "connect_stats_linux.go",
-				"func": "GetListeningSockets()",
-			}).Error(err.Error())
-			return nil, err
-		}
-		mapPs[state.Pid] = append(mapPs[state.Pid], state)
-	}
-	// Check if ps is exists
-	ps, err := exec.LookPath("ps")
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "connect_stats_linux.go",
-			"func": "GetListeningSockets()",
-		}).Error(err.Error())
-		return nil, err
-	}
-	out, err = exec.Command(ps, "-aux").Output()
+	inodes, err := getSocketInodes()
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "connect_stats_linux.go",
@@ -69,14 +54,11 @@ func GetListeningSockets() ([]*api.ListeningSocket, error) {
 		}).Error(err.Error())
 		return nil, err
 	}
-	res := make([]*api.ListeningSocket, 0, 5)
-	scanner = bufio.NewScanner(bytes.NewReader(out))
-	scanner.Split(bufio.ScanLines)
-	// Filter the header
-	scanner.Scan()
-	for scanner.Scan() {
-		line := scanner.Text()
-		state, err := parserPS(line)
+	users := getUserNames()
+
+	res := make([]*api.ListeningSocket, 0, 20)
+	for _, protocol := range []string{"tcp", "tcp6", "udp", "udp6"} {
+		sockets, err := readProcNet(protocol)
 		if err != nil {
 			logger.Log.WithFields(logrus.Fields{
 				"file": "connect_stats_linux.go",
@@ -84,12 +66,27 @@ func GetListeningSockets() ([]*api.ListeningSocket, error) {
 			}).Error(err.Error())
 			return nil, err
 		}
-		if v, ok := mapPs[state.Pid]; ok {
-			for i := range v {
-				v[i].Command = state.Command
-				v[i].User = state.User
-				res = append(res, v[i])
+		for _, s := range sockets {
+			if strings.HasPrefix(protocol, "tcp") && s.State != tcpListen {
+				continue
+			}
+			// unconnected UDP sockets are the listening ones (netstat -lu)
+			if strings.HasPrefix(protocol, "udp") && (s.State != udpClose || s.RemotePort != 0) {
+				continue
 			}
+			ls := &api.ListeningSocket{
+				Protocol: protocol,
+				Port:     s.LocalPort,
+				User:     userName(users, s.UID),
+			}
+			if pid, ok := inodes[s.Inode]; ok {
+				ls.Pid = pid
+				if uid, err := getProcessUID(pid); err == nil {
+					ls.User = userName(users, uid)
+				}
+				ls.Command, _ = getProcessCommand(pid)
+			}
+			res = append(res, ls)
 		}
 	}
 
@@ -102,27 +99,9 @@ func GetListeningSockets() ([]*api.ListeningSocket, error) {
 }
 
 func GetConnects() ([]*api.Connect, error) {
-	// Check if ss is exists
-	ss, err := exec.LookPath("ss")
-	if err != nil {
-		return nil, err
-	}
-	out, err := exec.Command(ss, "-ta").Output()
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "connect_stats_linux.go",
-			"func": "GetConnects()",
-		}).Error(err.Error())
-		return nil, err
-	}
 	mapSS := make(map[string]*api.Connect, 20)
-	scanner := bufio.NewScanner(bytes.NewReader(out))
-	scanner.Split(bufio.ScanLines)
-	// Filter the header
-	scanner.Scan()
-	for scanner.Scan() {
-		line := scanner.Text()
-		state, err := parserSS(line)
+	for _, protocol := range []string{"tcp", "tcp6"} {
+		sockets, err := readProcNet(protocol)
 		if err != nil {
 			logger.Log.WithFields(logrus.Fields{
 				"file": "connect_stats_linux.go",
@@ -130,12 +109,16 @@ func GetConnects() ([]*api.Connect, error) {
 			}).Error(err.Error())
 			return nil, err
 		}
-		if v, ok := mapSS[state]; ok {
-			v.State = state
-			v.Number++
-			mapSS[state] = v
-		} else {
-			mapSS[state] = &api.Connect{State: state, Number: 1}
+		for _, s := range sockets {
+			state, ok := tcpStates[s.State]
+			if !ok {
+				state = s.State
+			}
+			if v, ok := mapSS[state]; ok {
+				v.Number++
+			} else {
+				mapSS[state] = &api.Connect{State: state, Number: 1}
+			}
 		}
 	}
 
@@ -144,123 +127,84 @@ func GetConnects() ([]*api.Connect, error) {
 		"func": "GetConnects()",
 	}).Debug("")
 
-	return maps.Values(mapSS), nil
+	res := maps.Values(mapSS)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].State < res[j].State
+	})
+
+	return res, nil
 }
 
-func parserNetStat(line string) (*api.ListeningSocket, error) {
-	ls := &api.ListeningSocket{}
-	fields := strings.Fields(line)
-	if len(fields) < 6 {
-		err := "couldn't parse netstat because there are less than 6 fields"
-		logger.Log.WithFields(logrus.Fields{
-			"file": "connect_stats_linux.go",
-			"func": "parserNetStat()",
-		}).Error(err)
-		return ls, errors.New(err)
+// readProcNet parses /proc/net/{tcp,tcp6,udp,udp6}. A missing file
+// (IPv6 disabled) yields no sockets.
+func readProcNet(protocol string) ([]procNetSocket, error) {
+	file, err := os.Open(procSelfPath("net", protocol))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
 	}
-	// Parse fields
-	for i := 0; i < len(fields); i++ {
-		field := fields[i]
-		switch i {
-		case 0:
-			ls.Protocol = field
-		case 3:
-			addr := strings.Split(field, ":")
-			if len(addr) == 0 {
-				continue
-			}
-			value, err := strconv.ParseUint(addr[len(addr)-1], 10, 16)
-			if err != nil {
-				logger.Log.WithFields(logrus.Fields{
-					"file": "connect_stats_linux.go",
-					"func": "parserNetStat()",
-				}).Error(err)
-				return &api.ListeningSocket{}, err
-			}
-			ls.Port = uint32(value)
-		case 5:
-			if field == "LISTEN" {
-				break
-			}
-			program := strings.Split(field, "/")
-			if len(program) == 0 {
-				continue
-			}
-			pid, err := strconv.ParseUint(program[0], 10, 32)
-			if err != nil {
-				logger.Log.WithFields(logrus.Fields{
-					"file": "connect_stats_linux.go",
-					"func": "parserNetStat()",
-				}).Error(err)
-				return &api.ListeningSocket{}, err
-			}
-			ls.Pid = uint32(pid)
-			return ls, nil
-		case 6:
-			program := strings.Split(field, "/")
-			if len(program) == 0 {
-				continue
-			}
-			pid, err := strconv.ParseUint(program[0], 10, 32)
-			if err != nil {
-				logger.Log.WithFields(logrus.Fields{
-					"file": "connect_stats_linux.go",
-					"func": "parserNetStat()",
-				}).Error(err)
-				return &api.ListeningSocket{}, err
-			}
-			ls.Pid = uint32(pid)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	res := make([]procNetSocket, 0, 20)
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	// Filter the header
+	scanner.Scan()
+	for scanner.Scan() {
+		s, err := parseProcNetLine(scanner.Text())
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, s)
 	}
 
-	return ls, nil
+	return res, scanner.Err()
 }
 
-func parserPS(line string) (api.ListeningSocket, error) {
-	ls := api.ListeningSocket{}
+func parseProcNetLine(line string) (procNetSocket, error) {
+	s := procNetSocket{}
 	fields := strings.Fields(line)
-	if len(fields) < 11 {
-		err := "couldn't parse ps because there aren't 11 fields"
+	if len(fields) < 10 {
+		err := "couldn't parse /proc/net because there are less than 10 fields"
 		logger.Log.WithFields(logrus.Fields{
 			"file": "connect_stats_linux.go",
-			"func": "GetListeningSockets()",
+			"func": "parseProcNetLine()",
 		}).Error(err)
-		return ls, errors.New(err) //nolint:all
+		return s, errors.New(err)
 	}
-	// Parse fields
-	for i := 0; i < len(fields); i++ {
-		field := fields[i]
-		switch i {
-		case 0:
-			ls.User = field
-		case 1:
-			value, err := strconv.ParseUint(field, 10, 64)
-			if err != nil {
-				return api.ListeningSocket{}, err
-			}
-			ls.Pid = uint32(value)
-		case 10:
-			ls.Command = field
-		default:
-			if i > 10 {
-				ls.Command += " " + field
-			}
-		}
+	localPort, err := parseHexPort(fields[1])
+	if err != nil {
+		return s, err
+	}
+	remotePort, err := parseHexPort(fields[2])
+	if err != nil {
+		return s, err
 	}
+	inode, err := strconv.ParseUint(fields[9], 10, 64)
+	if err != nil {
+		return s, err
+	}
+	s.LocalPort = localPort
+	s.RemotePort = remotePort
+	s.State = fields[3]
+	s.UID = fields[7]
+	s.Inode = inode
 
-	return ls, nil //nolint:all
+	return s, nil
 }
 
-func parserSS(line string) (string, error) {
-	fields := strings.Fields(line)
-	if len(fields) < 5 {
-		err := "couldn't parse ss because there aren't less 5 fields"
-		logger.Log.WithFields(logrus.Fields{
-			"file": "connect_stats_linux.go",
-			"func": "GetListeningSockets()",
-		}).Error(err)
-		return "", errors.New(err)
+// parseHexPort takes the port of an "ADDR:PORT" pair written in hex.
+func parseHexPort(addr string) (uint32, error) {
+	idx := strings.LastIndexByte(addr, ':')
+	if idx < 0 {
+		return 0, errors.New("couldn't parse address " + addr)
+	}
+	port, err := strconv.ParseUint(addr[idx+1:], 16, 16)
+	if err != nil {
+		return 0, err
 	}
 
-	return fields[0], nil
+	return uint32(port), nil
 }
@@ -10,8 +10,8 @@ import (
 func TestGetListeningSockets(t *testing.T) {
 	logger.Init("Debug")
 	t.Run("sympe", func(t *testing.T) {
-		c, _ := GetListeningSockets()
-		require.Nil(t, c)
+		_, err := GetListeningSockets()
+		require.Nil(t, err)
 	})
 }
 
@@ -22,3 +22,32 @@ func TestGetConnects(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestParseProcNetLine(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("tcp listen", func(t *testing.T) {
+		s, err := parseProcNetLine("   0: 00000000:1F90 00000000:0000 0A 00000000:00000000 " +
+			"00:00000000 00000000     0        0 21435 1 0000000000000000 100 0 0 10 0")
+		require.Nil(t, err)
+		require.Equal(t, uint32(8080), s.LocalPort)
+		require.Equal(t, uint32(0), s.RemotePort)
+		require.Equal(t, tcpListen, s.State)
+		require.Equal(t, "0", s.UID)
+		require.Equal(t, uint64(21435), s.Inode)
+	})
+
+	t.Run("tcp6 established", func(t *testing.T) {
+		s, err := parseProcNetLine("   1: 0000000000000000FFFF00000100007F:0016 " +
+			"0000000000000000FFFF00000100007F:D2F0 01 00000000:00000000 02:000A8C4E 00000000  1000        0 9876 2")
+		require.Nil(t, err)
+		require.Equal(t, uint32(22), s.LocalPort)
+		require.Equal(t, uint32(54000), s.RemotePort)
+		require.Equal(t, "ESTAB", tcpStates[s.State])
+		require.Equal(t, "1000", s.UID)
+	})
+
+	t.Run("short line", func(t *testing.T) {
+		_, err := parseProcNetLine("   0: 00000000:1F90 00000000:0000 0A")
+		require.NotNil(t, err)
+	})
+}
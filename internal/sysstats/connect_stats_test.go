@@ -0,0 +1,33 @@
+package sysstats
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortListeningSockets(t *testing.T) {
+	ls := []*api.ListeningSocket{
+		{Protocol: "udp", Port: 53, Pid: 300},
+		{Protocol: "tcp6", Port: 22, Pid: 100},
+		{Protocol: "tcp", Port: 22, Pid: 100},
+		{Protocol: "tcp", Port: 8080, Pid: 200},
+	}
+
+	SortListeningSockets(ls, "")
+	require.Equal(t,
+		[]string{"tcp8080", "udp53", "tcp22", "tcp622"},
+		[]string{socketName(ls[0]), socketName(ls[1]), socketName(ls[2]), socketName(ls[3])})
+
+	SortListeningSockets(ls, config.SortByPid)
+	require.Equal(t,
+		[]string{"udp53", "tcp8080", "tcp22", "tcp622"},
+		[]string{socketName(ls[0]), socketName(ls[1]), socketName(ls[2]), socketName(ls[3])})
+}
+
+func socketName(s *api.ListeningSocket) string {
+	return s.Protocol + strconv.Itoa(int(s.Port))
+}
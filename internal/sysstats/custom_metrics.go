@@ -0,0 +1,188 @@
+package sysstats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+// jsonMetric is a metric of the JSON output:
+// [{"name": "raid_degraded", "labels": {"ctrl": "0"}, "value": 1, "unit": "bool"}].
+type jsonMetric struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+	Value  *float64          `json:"value"`
+	Unit   string            `json:"unit"`
+}
+
+// parseMetrics parses metrics in the format, an empty format is detected:
+// JSON starts with '[' or '{', anything else is Prometheus text.
+func parseMetrics(format string, data []byte) ([]*api.CustomMetric, error) {
+	if format == "" {
+		format = config.PluginFormatPrometheus
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+			format = config.PluginFormatJSON
+		}
+	}
+	if format == config.PluginFormatJSON {
+		return parseJSONMetrics(data)
+	}
+
+	return parsePrometheusMetrics(data)
+}
+
+// parseJSONMetrics parses an array of metrics or a single metric.
+func parseJSONMetrics(data []byte) ([]*api.CustomMetric, error) {
+	var metrics []jsonMetric
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		metrics = make([]jsonMetric, 1)
+		if err := json.Unmarshal(data, &metrics[0]); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, err
+	}
+
+	res := make([]*api.CustomMetric, 0, len(metrics))
+	for _, m := range metrics {
+		if m.Name == "" || m.Value == nil {
+			return nil, errors.New("metric must have name and value")
+		}
+		res = append(res, &api.CustomMetric{
+			Name:   m.Name,
+			Labels: m.Labels,
+			Value:  *m.Value,
+			Unit:   m.Unit,
+		})
+	}
+
+	return res, nil
+}
+
+// parsePrometheusMetrics parses the Prometheus text format, the units come
+// from the OpenMetrics "# UNIT" lines.
+func parsePrometheusMetrics(data []byte) ([]*api.CustomMetric, error) {
+	res := make([]*api.CustomMetric, 0, 16)
+	units := make(map[string]string, 4)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if line[0] == '#' {
+			if fields := strings.Fields(line); len(fields) >= 4 && fields[1] == "UNIT" {
+				units[fields[2]] = fields[3]
+			}
+			continue
+		}
+		m, err := parsePrometheusLine(line)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, m)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for _, m := range res {
+		m.Unit = units[metricFamily(m.Name, units)]
+	}
+
+	return res, nil
+}
+
+// metricFamily returns the family of the sample name ("http_requests" of
+// "http_requests_total") when the family has a unit.
+func metricFamily(name string, units map[string]string) string {
+	if _, ok := units[name]; ok {
+		return name
+	}
+	for _, suffix := range []string{"_total", "_sum", "_count", "_bucket", "_created"} {
+		if family := strings.TrimSuffix(name, suffix); family != name {
+			if _, ok := units[family]; ok {
+				return family
+			}
+		}
+	}
+
+	return name
+}
+
+// parsePrometheusLine parses a sample: name{label="value",...} value [timestamp].
+func parsePrometheusLine(line string) (*api.CustomMetric, error) {
+	end := strings.IndexAny(line, "{ \t")
+	if end <= 0 {
+		return nil, fmt.Errorf("couldn't parse metric line: %s", line)
+	}
+	m := &api.CustomMetric{Name: line[:end]}
+	rest := line[end:]
+	if rest[0] == '{' {
+		labels, n, err := parsePrometheusLabels(rest[1:])
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse labels of %s: %w", m.Name, err)
+		}
+		m.Labels = labels
+		rest = rest[1+n:]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("metric %s has no value", m.Name)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse value of %s: %w", m.Name, err)
+	}
+	m.Value = value
+
+	return m, nil
+}
+
+// parsePrometheusLabels parses the labels after '{' and returns the number
+// of bytes read including the closing '}'.
+func parsePrometheusLabels(s string) (map[string]string, int, error) {
+	labels := make(map[string]string, 4)
+	i := 0
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == ',') {
+			i++
+		}
+		if i >= len(s) {
+			return nil, 0, errors.New("no closing brace")
+		}
+		if s[i] == '}' {
+			return labels, i + 1, nil
+		}
+		eq := strings.IndexByte(s[i:], '=')
+		if eq <= 0 || i+eq+1 >= len(s) || s[i+eq+1] != '"' {
+			return nil, 0, errors.New("wrong label")
+		}
+		name := strings.TrimSpace(s[i : i+eq])
+		i += eq + 2
+		var value strings.Builder
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+				if s[i] == 'n' {
+					value.WriteByte('\n')
+					continue
+				}
+			}
+			value.WriteByte(s[i])
+		}
+		if i >= len(s) {
+			return nil, 0, errors.New("unterminated label value")
+		}
+		i++
+		labels[name] = value.String()
+	}
+}
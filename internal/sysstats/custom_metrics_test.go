@@ -0,0 +1,64 @@
+package sysstats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetrics(t *testing.T) {
+	t.Run("json array", func(t *testing.T) {
+		res, err := parseMetrics("", []byte(` [{"name": "raid_degraded", "labels": {"ctrl": "0"}, "value": 1, "unit": "bool"},
+			{"name": "queue_depth", "value": 42}]`))
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, "raid_degraded", res[0].Name)
+		require.Equal(t, map[string]string{"ctrl": "0"}, res[0].Labels)
+		require.Equal(t, float64(1), res[0].Value)
+		require.Equal(t, "bool", res[0].Unit)
+		require.Equal(t, float64(42), res[1].Value)
+	})
+
+	t.Run("json object", func(t *testing.T) {
+		res, err := parseMetrics("json", []byte(`{"name": "queue_depth", "value": 0}`))
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, float64(0), res[0].Value)
+	})
+
+	t.Run("json without value", func(t *testing.T) {
+		_, err := parseMetrics("", []byte(`[{"name": "queue_depth"}]`))
+		require.NotNil(t, err)
+	})
+
+	t.Run("prometheus", func(t *testing.T) {
+		res, err := parseMetrics("", []byte(`# HELP app_queue_bytes Bytes in the queue.
+# TYPE app_queue_bytes gauge
+# UNIT app_queue_bytes bytes
+app_queue_bytes{queue="in",host="a b"} 1024
+app_queue_bytes{queue="out\"x\"",} 2e3 1700000000000
+# UNIT app_requests seconds
+app_requests_total 7
+app_up NaN
+`))
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+		require.Equal(t, map[string]string{"queue": "in", "host": "a b"}, res[0].Labels)
+		require.Equal(t, float64(1024), res[0].Value)
+		require.Equal(t, "bytes", res[0].Unit)
+		require.Equal(t, `out"x"`, res[1].Labels["queue"])
+		require.Equal(t, float64(2000), res[1].Value)
+		require.Equal(t, "app_requests_total", res[2].Name)
+		require.Equal(t, "seconds", res[2].Unit)
+		require.True(t, math.IsNaN(res[3].Value))
+		require.Nil(t, res[3].Labels)
+	})
+
+	t.Run("prometheus broken", func(t *testing.T) {
+		_, err := parseMetrics("prometheus", []byte(`app_queue_bytes{queue="in" 1`))
+		require.NotNil(t, err)
+		_, err = parseMetrics("prometheus", []byte("app_queue_bytes\n"))
+		require.NotNil(t, err)
+	})
+}
@@ -6,16 +6,24 @@ import (
 	"bufio"
 	"errors"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/lixoi/system_stats_daemon/config"
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/sirupsen/logrus"
 )
 
-func GetDiskStats() (*api.DiskStats, error) {
-	file, err := os.Open("/proc/diskstats")
+var diskStatsSampler = &diskSampler{}
+
+// GetDiskStats returns per-device I/O time rates since the previous call.
+// The first call only takes a sample and returns no devices.
+func GetDiskStats(conf config.DiskStatsConfig) ([]*api.DiskStats, error) {
+	cur, prev, seconds, err := diskStatsSampler.next()
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "disk_stats_linux.go",
@@ -23,70 +31,160 @@ func GetDiskStats() (*api.DiskStats, error) {
 		}).Error(err.Error())
 		return nil, err
 	}
+
+	diskStats := make([]*api.DiskStats, 0, 10)
+	if prev == nil || seconds <= 0 {
+		return diskStats, nil
+	}
+	for name, c := range cur {
+		if conf.IncludeDevices != nil && !conf.IncludeDevices.MatchString(name) {
+			continue
+		}
+		if conf.ExcludeDevices != nil && conf.ExcludeDevices.MatchString(name) {
+			continue
+		}
+		p, ok := prev[name]
+		if !ok {
+			continue
+		}
+		diskStats = append(diskStats, &api.DiskStats{
+			Device:       name,
+			Partition:    c.Partition,
+			IoTime:       float64(counterDelta(c.IOTicks, p.IOTicks)) / seconds,
+			IoInProgress: float64(c.InFlight),
+			WeightedIo:   float64(counterDelta(c.TimeInQueue, p.TimeInQueue)) / seconds,
+		})
+	}
+	sort.Slice(diskStats, func(i, j int) bool {
+		return diskStats[i].Device < diskStats[j].Device
+	})
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "disk_stats_linux.go",
+		"func": "GetDiskStats()",
+	}).Debug("")
+
+	return diskStats, nil
+}
+
+// diskSample is one line of /proc/diskstats (Documentation/admin-guide/iostats.rst).
+type diskSample struct {
+	Name         string
+	Partition    bool
+	ReadIOs      uint64
+	ReadSectors  uint64
+	ReadTicks    uint64
+	WriteIOs     uint64
+	WriteSectors uint64
+	WriteTicks   uint64
+	InFlight     uint64
+	IOTicks      uint64
+	TimeInQueue  uint64
+}
+
+// diskSampler keeps the previous /proc/diskstats sample to compute rates.
+type diskSampler struct {
+	mu   sync.Mutex
+	prev map[string]diskSample
+	ts   time.Time
+}
+
+// next reads a new sample and returns it with the previous one and the
+// seconds elapsed between them. The first call returns a nil previous sample.
+func (ds *diskSampler) next() (map[string]diskSample, map[string]diskSample, float64, error) {
+	cur, err := readDiskStats()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	now := time.Now()
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	prev, seconds := ds.prev, now.Sub(ds.ts).Seconds()
+	ds.prev, ds.ts = cur, now
+
+	return cur, prev, seconds, nil
+}
+
+func readDiskStats() (map[string]diskSample, error) {
+	file, err := os.Open(procPath("diskstats"))
+	if err != nil {
+		return nil, err
+	}
 	defer file.Close()
 
+	wholeDisks := getWholeDisks()
+	res := make(map[string]diskSample, 10)
 	scanner := bufio.NewScanner(file)
 	scanner.Split(bufio.ScanLines)
 	for scanner.Scan() {
-		line := scanner.Text()
-		if !strings.Contains(line, "loop") {
+		sample, err := parseDiskSample(scanner.Text())
+		if err != nil {
 			logger.Log.WithFields(logrus.Fields{
 				"file": "disk_stats_linux.go",
-				"func": "GetDiskStats()",
-			}).Debug("")
-			return parseDiskStats(line)
+				"func": "readDiskStats()",
+			}).Error(err.Error())
+			return nil, err
 		}
+		if wholeDisks != nil {
+			_, ok := wholeDisks[sample.Name]
+			sample.Partition = !ok
+		}
+		res[sample.Name] = sample
 	}
 
-	errMsg := "not data"
-	logger.Log.WithFields(logrus.Fields{
-		"file": "disk_stats_linux.go",
-		"func": "GetDiskStats()",
-	}).Error(err)
-	return nil, errors.New(errMsg)
+	return res, scanner.Err()
 }
 
-func parseDiskStats(stats string) (*api.DiskStats, error) {
-	diskStats := &api.DiskStats{}
-	fields := strings.Fields(stats)
+func parseDiskSample(line string) (diskSample, error) {
+	fields := strings.Fields(line)
 	if len(fields) < 14 {
-		err := "couldn't parse /proc/diskstats because there are less than 14 fields"
-		logger.Log.WithFields(logrus.Fields{
-			"file": "disk_stats_linux.go",
-			"func": "parseDiskStats()",
-		}).Error(err)
-		return nil, errors.New(err)
+		return diskSample{}, errors.New("couldn't parse /proc/diskstats because there are less than 14 fields")
 	}
-
-	ioTime, err := strconv.ParseFloat(fields[12], 64)
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "disk_stats_linux.go",
-			"func": "parseDiskStats()",
-		}).Error(err.Error())
-		return nil, err
+	values := make([]uint64, 14)
+	for i := 3; i < 14; i++ {
+		value, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return diskSample{}, err
+		}
+		values[i] = value
 	}
-	diskStats.IoTime = ioTime
 
-	ioInProgress, err := strconv.ParseFloat(fields[11], 64)
+	return diskSample{
+		Name:         fields[2],
+		ReadIOs:      values[3],
+		ReadSectors:  values[5],
+		ReadTicks:    values[6],
+		WriteIOs:     values[7],
+		WriteSectors: values[9],
+		WriteTicks:   values[10],
+		InFlight:     values[11],
+		IOTicks:      values[12],
+		TimeInQueue:  values[13],
+	}, nil
+}
+
+// getWholeDisks lists /sys/block, partitions live only below their disk.
+// Returns nil if sysfs is not available.
+func getWholeDisks() map[string]struct{} {
+	entries, err := os.ReadDir(sysPath("block"))
 	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "disk_stats_linux.go",
-			"func": "parseDiskStats()",
-		}).Error(err.Error())
-		return nil, err
+		return nil
+	}
+	res := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		// cciss!c0d0 in sysfs is cciss/c0d0 in /proc/diskstats
+		res[strings.ReplaceAll(entry.Name(), "!", "/")] = struct{}{}
 	}
-	diskStats.IoInProgress = ioInProgress
 
-	weightedIO, err := strconv.ParseFloat(fields[13], 64)
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "disk_stats_linux.go",
-			"func": "parseDiskStats()",
-		}).Error(err.Error())
-		return nil, err
+	return res
+}
+
+// counterDelta is cur-prev, or zero if the counter was reset or wrapped.
+func counterDelta(cur, prev uint64) uint64 {
+	if cur < prev {
+		return 0
 	}
-	diskStats.WeightedIo = weightedIO
 
-	return diskStats, nil
+	return cur - prev
 }
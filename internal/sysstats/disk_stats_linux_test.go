@@ -1,8 +1,10 @@
 package sysstats
 
 import (
+	"regexp"
 	"testing"
 
+	"github.com/lixoi/system_stats_daemon/config"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/stretchr/testify/require"
 )
@@ -10,8 +12,34 @@ import (
 func TestGetDiskStats(t *testing.T) {
 	logger.Init("Debug")
 	t.Run("sympe", func(t *testing.T) {
-		c, err := GetDiskStats()
+		conf := config.DiskStatsConfig{ExcludeDevices: regexp.MustCompile("^loop")}
+		_, err := GetDiskStats(conf)
 		require.Nil(t, err)
-		require.True(t, c.IoTime > 0)
+		c, err := GetDiskStats(conf)
+		require.Nil(t, err)
+		require.True(t, len(c) > 0)
+		for i := range c {
+			require.NotRegexp(t, "^loop", c[i].Device)
+			require.True(t, c[i].IoTime >= 0)
+		}
+	})
+}
+
+func TestParseDiskSample(t *testing.T) {
+	t.Run("kernel 5.5+", func(t *testing.T) {
+		s, err := parseDiskSample(" 253       0 vda 9412 3270 1154862 4307 97812 52960 " +
+			"3350056 61520 2 97500 68870 0 0 0 0 10480 3042")
+		require.Nil(t, err)
+		require.Equal(t, "vda", s.Name)
+		require.Equal(t, uint64(9412), s.ReadIOs)
+		require.Equal(t, uint64(3350056), s.WriteSectors)
+		require.Equal(t, uint64(2), s.InFlight)
+		require.Equal(t, uint64(97500), s.IOTicks)
+		require.Equal(t, uint64(68870), s.TimeInQueue)
+	})
+
+	t.Run("short line", func(t *testing.T) {
+		_, err := parseDiskSample("   7       0 loop0 0 0 0")
+		require.NotNil(t, err)
 	})
 }
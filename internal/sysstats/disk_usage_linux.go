@@ -4,30 +4,28 @@ package sysstats
 
 import (
 	"bufio"
-	"bytes"
 	"errors"
-	"os/exec"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 
+	"github.com/lixoi/system_stats_daemon/config"
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
-func GetDiskUsage() ([]*api.DiskUsage, error) {
-	// Check if df is exists
-	df, err := exec.LookPath("df")
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "disk_usage_linux.go",
-			"func": "GetDiskUsage()",
-		}).Error(err.Error())
-		return nil, err
-	}
-	// Use Mb
-	out, err := exec.Command(df, "-k").Output()
+type mountInfo struct {
+	MountPoint string
+	FSType     string
+	Source     string
+}
+
+func GetDiskUsage(conf config.DiskUsageConfig) ([]*api.DiskUsage, error) {
+	mounts, err := readMountInfo(procSelfPath("mountinfo"))
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "disk_usage_linux.go",
@@ -35,99 +33,135 @@ func GetDiskUsage() ([]*api.DiskUsage, error) {
 		}).Error(err.Error())
 		return nil, err
 	}
-	disk := make(map[string]*api.DiskUsage, 5)
-	scanner := bufio.NewScanner(bytes.NewReader(out))
-	scanner.Split(bufio.ScanLines)
-	// Filter the header
-	scanner.Scan()
-	for scanner.Scan() {
-		line := scanner.Text()
-		diskUsage, err := parserDF(line)
-		if err != nil {
+
+	disk := make(map[string]*api.DiskUsage, len(mounts))
+	for _, m := range mounts {
+		if len(conf.IncludeFSTypes) > 0 && !slices.Contains(conf.IncludeFSTypes, m.FSType) {
+			continue
+		}
+		if slices.Contains(conf.ExcludeFSTypes, m.FSType) {
+			continue
+		}
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(rootPath(m.MountPoint), &stat); err != nil {
 			logger.Log.WithFields(logrus.Fields{
 				"file": "disk_usage_linux.go",
 				"func": "GetDiskUsage()",
-			}).Error(err.Error())
-			return nil, err
+			}).Debug(m.MountPoint + ": " + err.Error())
+			continue
 		}
-		if v, ok := disk[diskUsage.FileSystem]; ok {
-			v.Use += diskUsage.Use
-			v.Used += diskUsage.Used
-			disk[diskUsage.FileSystem] = v
-		} else {
-			disk[diskUsage.FileSystem] = diskUsage
+		// pseudo file systems (proc, sysfs, cgroup ...) have no blocks, df hides them too
+		if stat.Blocks == 0 {
+			continue
 		}
+		// the last mount over the same point is the visible one
+		disk[m.MountPoint] = newDiskUsage(m, &stat)
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "disk_usage_linux.go",
+		"func": "GetDiskUsage()",
+	}).Debug("")
+
+	res := make([]*api.DiskUsage, 0, len(disk))
+	for _, v := range disk {
+		res = append(res, v)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].MountPoint < res[j].MountPoint
+	})
+
+	return res, nil
+}
+
+func newDiskUsage(m mountInfo, stat *syscall.Statfs_t) *api.DiskUsage {
+	blockSize := uint64(stat.Frsize)
+	if blockSize == 0 {
+		blockSize = uint64(stat.Bsize)
 	}
-	// Use Inode
-	out, err = exec.Command(df, "-i").Output()
+	du := &api.DiskUsage{
+		FileSystem: m.Source,
+		MountPoint: m.MountPoint,
+		FsType:     m.FSType,
+		Size:       stat.Blocks * blockSize / 1024,
+		Used:       (stat.Blocks - stat.Bfree) * blockSize / 1024,
+		Avail:      stat.Bavail * blockSize / 1024,
+		Inodes:     stat.Files,
+		Ifree:      stat.Ffree,
+	}
+	// the same rounding as df: reserved blocks are not available to users
+	du.Use = percentCeil(du.Used, du.Used+du.Avail)
+	du.Iused = du.Inodes - du.Ifree
+	du.Iuse = percentCeil(du.Iused, du.Inodes)
+
+	return du
+}
+
+func percentCeil(part, total uint64) uint64 {
+	if total == 0 {
+		return 0
+	}
+
+	return (part*100 + total - 1) / total
+}
+
+func readMountInfo(fpath string) ([]mountInfo, error) {
+	file, err := os.Open(fpath)
 	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "disk_usage_linux.go",
-			"func": "GetDiskUsage()",
-		}).Error(err.Error())
 		return nil, err
 	}
-	scanner = bufio.NewScanner(bytes.NewReader(out))
+	defer file.Close()
+
+	res := make([]mountInfo, 0, 30)
+	scanner := bufio.NewScanner(file)
 	scanner.Split(bufio.ScanLines)
-	// Filter the header
-	scanner.Scan()
 	for scanner.Scan() {
-		line := scanner.Text()
-		diskUsage, err := parserDF(line)
+		m, err := parseMountInfo(scanner.Text())
 		if err != nil {
 			logger.Log.WithFields(logrus.Fields{
 				"file": "disk_usage_linux.go",
-				"func": "GetDiskUsage()",
+				"func": "readMountInfo()",
 			}).Error(err.Error())
 			return nil, err
 		}
-		if v, ok := disk[diskUsage.FileSystem]; ok {
-			v.Iuse += diskUsage.Use
-			v.Iused += diskUsage.Used
-			disk[diskUsage.FileSystem] = v
-		}
+		res = append(res, m)
 	}
 
-	logger.Log.WithFields(logrus.Fields{
-		"file": "disk_usage_linux.go",
-		"func": "GetDiskUsage()",
-	}).Debug("")
-
-	return maps.Values(disk), nil
+	return res, scanner.Err()
 }
 
-func parserDF(line string) (*api.DiskUsage, error) {
-	diskUsage := &api.DiskUsage{}
+// parseMountInfo parses a line of /proc/<pid>/mountinfo:
+// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue.
+func parseMountInfo(line string) (mountInfo, error) {
 	fields := strings.Fields(line)
-	if len(fields) != 6 {
-		return diskUsage, errors.New("couldn't parse disk usage because there aren't 6 fields")
+	sep := slices.Index(fields, "-")
+	if sep < 6 || len(fields) < sep+3 {
+		return mountInfo{}, errors.New("couldn't parse mountinfo line: " + line)
 	}
-	// Parse fields
-	for i := 0; i < len(fields); i++ {
-		field := fields[i]
-		switch i {
-		case 0:
-			diskUsage.FileSystem = field
-		case 2:
-			value, err := strconv.ParseUint(field, 10, 64)
-			if err != nil {
-				return &api.DiskUsage{}, err
-			}
-			diskUsage.Used = value
-		case 4:
-			if last := len(field) - 1; last >= 0 && (field[last] == '%' || field[last] == '-') {
-				field = field[:last]
-			}
-			if field == "" {
+
+	return mountInfo{
+		MountPoint: unescapeMountInfo(fields[4]),
+		FSType:     fields[sep+1],
+		Source:     unescapeMountInfo(fields[sep+2]),
+	}, nil
+}
+
+// unescapeMountInfo decodes the octal escapes (\040 for space etc.) of mountinfo.
+func unescapeMountInfo(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
 				continue
 			}
-			value, err := strconv.ParseUint(field, 10, 64)
-			if err != nil {
-				return &api.DiskUsage{}, err
-			}
-			diskUsage.Use = value
 		}
+		b.WriteByte(s[i])
 	}
 
-	return diskUsage, nil
+	return b.String()
 }
@@ -0,0 +1,36 @@
+package sysstats
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDiskUsage(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		du, err := GetDiskUsage(config.DiskUsageConfig{ExcludeFSTypes: []string{"tmpfs"}})
+		require.Nil(t, err)
+		for i := range du {
+			require.NotEqual(t, "tmpfs", du[i].FsType)
+			require.True(t, du[i].Use <= 100)
+		}
+	})
+}
+
+func TestParseMountInfo(t *testing.T) {
+	t.Run("optional fields", func(t *testing.T) {
+		m, err := parseMountInfo("36 35 98:0 /mnt1 /mnt\\040data rw,noatime master:1 shared:2 - ext4 /dev/sda1 rw")
+		require.Nil(t, err)
+		require.Equal(t, "/mnt data", m.MountPoint)
+		require.Equal(t, "ext4", m.FSType)
+		require.Equal(t, "/dev/sda1", m.Source)
+	})
+
+	t.Run("broken line", func(t *testing.T) {
+		_, err := parseMountInfo("36 35 98:0 /mnt1 /mnt2 rw")
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,94 @@
+package sysstats
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+var errOutputTooLarge = errors.New("output is too large")
+
+// execCollector runs a plugin and reports the metrics it prints.
+type execCollector struct {
+	name string
+	conf config.PluginConfig
+}
+
+func newExecCollector(name string) Collector {
+	return &execCollector{name: name}
+}
+
+func (ec *execCollector) Name() string {
+	return ec.name
+}
+
+func (ec *execCollector) Init(conf config.DumpConf) error {
+	for _, p := range conf.Plugins {
+		if config.PluginCollectorName(p.Name) == ec.name {
+			ec.conf = p
+			return nil
+		}
+	}
+
+	return fmt.Errorf("plugin %s is not configured", ec.name)
+}
+
+// Collect runs the plugin, it is killed when ctx is done or its output is too large.
+func (ec *execCollector) Collect(ctx context.Context, dump *api.SystemDump) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	out := &limitedBuffer{max: ec.conf.MaxOutput, overflowed: cancel}
+	cmd := exec.CommandContext(ctx, ec.conf.Command, ec.conf.Args...)
+	cmd.Stdout = out
+	// children of the plugin may keep stdout open after it is killed
+	cmd.WaitDelay = time.Second
+	err := cmd.Run()
+	if out.overflow {
+		return fmt.Errorf("plugin %s: %w, max %d bytes", ec.conf.Name, errOutputTooLarge, ec.conf.MaxOutput)
+	}
+	if err != nil {
+		return fmt.Errorf("plugin %s: %w", ec.conf.Name, err)
+	}
+
+	metrics, err := parseMetrics(ec.conf.Format, out.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("plugin %s: %w", ec.conf.Name, err)
+	}
+	for _, m := range metrics {
+		m.Source = ec.conf.Name
+	}
+	dump.CM = append(dump.CM, metrics...)
+
+	return nil
+}
+
+func (ec *execCollector) Close() error {
+	return nil
+}
+
+// limitedBuffer fails the writes that would grow it over max and calls
+// overflowed, the plugin may ignore EPIPE and has to be killed. The buffer
+// is not embedded: its ReadFrom would let io.Copy bypass Write.
+type limitedBuffer struct {
+	buf        bytes.Buffer
+	max        int
+	overflow   bool
+	overflowed func()
+}
+
+func (lb *limitedBuffer) Write(p []byte) (int, error) {
+	if lb.buf.Len()+len(p) > lb.max {
+		lb.overflow = true
+		lb.overflowed()
+		return 0, errOutputTooLarge
+	}
+
+	return lb.buf.Write(p)
+}
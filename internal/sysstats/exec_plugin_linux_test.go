@@ -0,0 +1,63 @@
+package sysstats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlugin(t *testing.T, script string, maxOutput int) Collector {
+	t.Helper()
+	c, err := NewCollector(config.PluginCollectorName("test"))
+	require.Nil(t, err)
+	require.Nil(t, c.Init(config.DumpConf{Plugins: []config.PluginConfig{{
+		Name:      "test",
+		Command:   "/bin/sh",
+		Args:      []string{"-c", script},
+		MaxOutput: maxOutput,
+	}}}))
+
+	return c
+}
+
+func TestExecCollector(t *testing.T) {
+	t.Run("metrics", func(t *testing.T) {
+		c := newTestPlugin(t, `echo 'queue_depth{queue="in"} 3'`, config.DefaultPluginMaxOutput)
+		dump := &api.SystemDump{}
+		require.Nil(t, c.Collect(context.Background(), dump))
+		require.Len(t, dump.CM, 1)
+		require.Equal(t, "test", dump.CM[0].Source)
+		require.Equal(t, float64(3), dump.CM[0].Value)
+	})
+
+	t.Run("max output", func(t *testing.T) {
+		c := newTestPlugin(t, `while true; do echo 'queue_depth 3'; done`, 100)
+		err := c.Collect(context.Background(), &api.SystemDump{})
+		require.True(t, errors.Is(err, errOutputTooLarge))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		c := newTestPlugin(t, `sleep 10`, config.DefaultPluginMaxOutput)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		require.NotNil(t, c.Collect(ctx, &api.SystemDump{}))
+		require.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("exit status", func(t *testing.T) {
+		c := newTestPlugin(t, `exit 2`, config.DefaultPluginMaxOutput)
+		require.NotNil(t, c.Collect(context.Background(), &api.SystemDump{}))
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		c, err := NewCollector(config.PluginCollectorName("missing"))
+		require.Nil(t, err)
+		require.NotNil(t, c.Init(config.DumpConf{}))
+	})
+}
@@ -0,0 +1,50 @@
+package sysstats
+
+import (
+	"path/filepath"
+)
+
+// Roots of procfs, sysfs and the root file system of the monitored host,
+// a container sees the host with them mounted at e.g. /host/proc.
+var (
+	hostProc = "/proc"
+	hostSys  = "/sys"
+	hostRoot = "/"
+)
+
+// SetHostPaths changes the roots read by the collectors, empty values keep
+// the defaults. It must be called before the collectors are started.
+func SetHostPaths(proc, sys, root string) {
+	if proc != "" {
+		hostProc = proc
+	}
+	if sys != "" {
+		hostSys = sys
+	}
+	if root != "" {
+		hostRoot = root
+	}
+}
+
+func procPath(elem ...string) string {
+	return filepath.Join(append([]string{hostProc}, elem...)...)
+}
+
+func sysPath(elem ...string) string {
+	return filepath.Join(append([]string{hostSys}, elem...)...)
+}
+
+func rootPath(elem ...string) string {
+	return filepath.Join(append([]string{hostRoot}, elem...)...)
+}
+
+// procSelfPath returns the per-process file (mountinfo, net/dev ...) of the
+// daemon, or of init when a foreign procfs is mounted: the daemon's own pid
+// would resolve to the namespaces of its container.
+func procSelfPath(elem ...string) string {
+	if hostProc == "/proc" {
+		return procPath(append([]string{"self"}, elem...)...)
+	}
+
+	return procPath(append([]string{"1"}, elem...)...)
+}
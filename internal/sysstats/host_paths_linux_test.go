@@ -0,0 +1,33 @@
+package sysstats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetHostPaths(t *testing.T) {
+	logger.Init("Debug")
+	proc, sys, root := hostProc, hostSys, hostRoot
+	t.Cleanup(func() { hostProc, hostSys, hostRoot = proc, sys, root })
+
+	hostDir := t.TempDir()
+	SetHostPaths(filepath.Join(hostDir, "proc"), "", hostDir)
+	require.Equal(t, "/sys", hostSys)
+	require.Equal(t, filepath.Join(hostDir, "proc", "1", "net", "dev"), procSelfPath("net", "dev"))
+	require.Equal(t, filepath.Join(hostDir, "etc", "passwd"), rootPath("etc", "passwd"))
+
+	t.Run("host meminfo", func(t *testing.T) {
+		require.Nil(t, os.MkdirAll(procPath(), 0o755))
+		require.Nil(t, os.WriteFile(procPath("meminfo"), []byte(
+			"MemTotal:       16314744 kB\nMemFree:         1000000 kB\nMemAvailable:    8000000 kB\n"), 0o600))
+		require.Nil(t, os.WriteFile(procPath("vmstat"), []byte("pgpgin 1024\npgpgout 2048\n"), 0o600))
+		ms, err := GetMemoryStats()
+		require.Nil(t, err)
+		require.Equal(t, uint64(16314744), ms.Total)
+		require.Equal(t, uint64(16314744-8000000), ms.Used)
+	})
+}
@@ -0,0 +1,174 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// ifaceCounters are the columns of /proc/net/dev we need.
+type ifaceCounters struct {
+	RxBytes, RxPackets, RxErrors, RxDrops, Multicast uint64
+	TxBytes, TxPackets, TxErrors, TxDrops            uint64
+}
+
+// ifaceSampler keeps the previous /proc/net/dev sample to compute rates.
+type ifaceSampler struct {
+	mu   sync.Mutex
+	prev map[string]ifaceCounters
+	ts   time.Time
+}
+
+var interfaceSampler = &ifaceSampler{}
+
+// GetInterfaceStats returns per-interface traffic rates since the previous
+// call (zero on the first one) with MTU, operstate and speed from sysfs.
+func GetInterfaceStats() ([]*api.InterfaceStats, error) {
+	cur, err := readNetDev(procSelfPath("net", "dev"))
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "interface_stats_linux.go",
+			"func": "GetInterfaceStats()",
+		}).Error(err.Error())
+		return nil, err
+	}
+	now := time.Now()
+
+	interfaceSampler.mu.Lock()
+	prev, seconds := interfaceSampler.prev, now.Sub(interfaceSampler.ts).Seconds()
+	interfaceSampler.prev, interfaceSampler.ts = cur, now
+	interfaceSampler.mu.Unlock()
+
+	res := make([]*api.InterfaceStats, 0, len(cur))
+	for name, c := range cur {
+		is := &api.InterfaceStats{
+			Name:      name,
+			Mtu:       uint32(readSysNetInt(name, "mtu")),
+			Operstate: readSysNetString(name, "operstate"),
+			Speed:     readSysNetInt(name, "speed"),
+		}
+		if p, ok := prev[name]; ok {
+			setInterfaceRates(is, c, p, seconds)
+		}
+		res = append(res, is)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "interface_stats_linux.go",
+		"func": "GetInterfaceStats()",
+	}).Debug("")
+
+	return res, nil
+}
+
+// setInterfaceRates sets the rates of the counters over seconds between
+// the samples, they stay zero if no time passed.
+func setInterfaceRates(is *api.InterfaceStats, cur, prev ifaceCounters, seconds float64) {
+	if seconds <= 0 {
+		return
+	}
+	is.RxBps = float64(counterDelta(cur.RxBytes, prev.RxBytes)) / seconds
+	is.TxBps = float64(counterDelta(cur.TxBytes, prev.TxBytes)) / seconds
+	is.RxPps = float64(counterDelta(cur.RxPackets, prev.RxPackets)) / seconds
+	is.TxPps = float64(counterDelta(cur.TxPackets, prev.TxPackets)) / seconds
+	is.RxErrors = float64(counterDelta(cur.RxErrors, prev.RxErrors)) / seconds
+	is.TxErrors = float64(counterDelta(cur.TxErrors, prev.TxErrors)) / seconds
+	is.RxDrops = float64(counterDelta(cur.RxDrops, prev.RxDrops)) / seconds
+	is.TxDrops = float64(counterDelta(cur.TxDrops, prev.TxDrops)) / seconds
+	is.Multicast = float64(counterDelta(cur.Multicast, prev.Multicast)) / seconds
+}
+
+func readNetDev(fpath string) (map[string]ifaceCounters, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	res := make(map[string]ifaceCounters, 5)
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	// Filter the header
+	scanner.Scan()
+	scanner.Scan()
+	for scanner.Scan() {
+		name, counters, err := parseNetDev(scanner.Text())
+		if err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "interface_stats_linux.go",
+				"func": "readNetDev()",
+			}).Error(err.Error())
+			return nil, err
+		}
+		res[name] = counters
+	}
+
+	return res, scanner.Err()
+}
+
+// parseNetDev parses "  eth0: 22401059 1335 0 0 0 0 0 0 182632 1610 0 0 0 0 0 0",
+// big counters may stick to the colon.
+func parseNetDev(line string) (string, ifaceCounters, error) {
+	idx := strings.IndexByte(line, ':')
+	if idx < 0 {
+		return "", ifaceCounters{}, errors.New("couldn't parse /proc/net/dev line: " + line)
+	}
+	fields := strings.Fields(line[idx+1:])
+	if len(fields) < 16 {
+		return "", ifaceCounters{}, errors.New("couldn't parse /proc/net/dev because there are less than 16 fields")
+	}
+	values := make([]uint64, 16)
+	for i := range values {
+		value, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return "", ifaceCounters{}, err
+		}
+		values[i] = value
+	}
+
+	return strings.TrimSpace(line[:idx]), ifaceCounters{
+		RxBytes:   values[0],
+		RxPackets: values[1],
+		RxErrors:  values[2],
+		RxDrops:   values[3],
+		Multicast: values[7],
+		TxBytes:   values[8],
+		TxPackets: values[9],
+		TxErrors:  values[10],
+		TxDrops:   values[11],
+	}, nil
+}
+
+func readSysNetString(iface, attr string) string {
+	value, err := os.ReadFile(sysPath("class", "net", iface, attr))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(value))
+}
+
+// readSysNetInt returns -1 for attributes the driver doesn't report
+// (speed of virtual or down interfaces gives EINVAL).
+func readSysNetInt(iface, attr string) int64 {
+	value, err := strconv.ParseInt(readSysNetString(iface, attr), 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return value
+}
@@ -0,0 +1,78 @@
+package sysstats
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInterfaceStats(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		is, err := GetInterfaceStats()
+		require.Nil(t, err)
+		require.True(t, len(is) > 0)
+		for i := 1; i < len(is); i++ {
+			require.Less(t, is[i-1].Name, is[i].Name)
+		}
+	})
+}
+
+func TestParseNetDev(t *testing.T) {
+	t.Run("eth0", func(t *testing.T) {
+		name, c, err := parseNetDev("  eth0: 22401059 1335 1 2 0 0 0 7 182632 1610 3 4 0 0 0 0")
+		require.Nil(t, err)
+		require.Equal(t, "eth0", name)
+		require.Equal(t, ifaceCounters{
+			RxBytes: 22401059, RxPackets: 1335, RxErrors: 1, RxDrops: 2, Multicast: 7,
+			TxBytes: 182632, TxPackets: 1610, TxErrors: 3, TxDrops: 4,
+		}, c)
+	})
+
+	t.Run("counter stuck to the colon", func(t *testing.T) {
+		name, c, err := parseNetDev("enp3s0:12345678901 9 0 0 0 0 0 0 42 1 0 0 0 0 0 0")
+		require.Nil(t, err)
+		require.Equal(t, "enp3s0", name)
+		require.Equal(t, uint64(12345678901), c.RxBytes)
+		require.Equal(t, uint64(42), c.TxBytes)
+	})
+
+	t.Run("short line", func(t *testing.T) {
+		_, _, err := parseNetDev("    lo: 100 1 0 0")
+		require.NotNil(t, err)
+	})
+
+	t.Run("no colon", func(t *testing.T) {
+		_, _, err := parseNetDev("Inter-|   Receive")
+		require.NotNil(t, err)
+	})
+}
+
+func TestSetInterfaceRates(t *testing.T) {
+	t.Run("two samples", func(t *testing.T) {
+		prev := ifaceCounters{RxBytes: 1000, RxPackets: 10, TxBytes: 500, TxPackets: 5, RxDrops: 1, Multicast: 2}
+		cur := ifaceCounters{RxBytes: 5000, RxPackets: 30, TxBytes: 2500, TxPackets: 9, RxDrops: 3, Multicast: 6}
+		is := &api.InterfaceStats{}
+		setInterfaceRates(is, cur, prev, 2)
+		require.Equal(t, float64(2000), is.RxBps)
+		require.Equal(t, float64(1000), is.TxBps)
+		require.Equal(t, float64(10), is.RxPps)
+		require.Equal(t, float64(2), is.TxPps)
+		require.Equal(t, float64(1), is.RxDrops)
+		require.Equal(t, float64(2), is.Multicast)
+	})
+
+	t.Run("counter reset", func(t *testing.T) {
+		is := &api.InterfaceStats{}
+		setInterfaceRates(is, ifaceCounters{RxBytes: 10}, ifaceCounters{RxBytes: 1000}, 1)
+		require.Equal(t, float64(0), is.RxBps)
+	})
+
+	t.Run("no time passed", func(t *testing.T) {
+		is := &api.InterfaceStats{}
+		setInterfaceRates(is, ifaceCounters{RxBytes: 10}, ifaceCounters{}, 0)
+		require.Equal(t, float64(0), is.RxBps)
+	})
+}
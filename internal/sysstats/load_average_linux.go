@@ -37,7 +37,7 @@ func GetLoadAvg() (*api.LoadAverage, error) {
 }
 
 func getLoadAvgFromFile() (*api.LoadAverage, error) {
-	file, err := ioutil.ReadFile("/proc/loadavg")
+	file, err := ioutil.ReadFile(procPath("loadavg"))
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "load_average_linux.go",
@@ -8,14 +8,38 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/sirupsen/logrus"
 )
 
+// cpuTimes are the jiffies of a cpu line of /proc/stat:
+// user nice system idle iowait irq softirq steal (guest is part of user).
+type cpuTimes [8]uint64
+
+func (ct cpuTimes) total() uint64 {
+	var res uint64
+	for i := range ct {
+		res += ct[i]
+	}
+
+	return res
+}
+
+// cpuSampler keeps the previous /proc/stat sample to compute percentages.
+type cpuSampler struct {
+	mu   sync.Mutex
+	prev map[string]cpuTimes
+}
+
+var loadCPUSampler = &cpuSampler{}
+
+// GetLoadCPU returns the CPU utilisation in percents since the previous call,
+// the first call gives the utilisation since boot.
 func GetLoadCPU() (*api.LoadCPU, error) {
-	file, err := os.Open("/proc/stat")
+	cur, order, err := readCPUTimes()
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "load_cpu_linux.go",
@@ -23,8 +47,72 @@ func GetLoadCPU() (*api.LoadCPU, error) {
 		}).Error(err.Error())
 		return nil, err
 	}
+
+	loadCPUSampler.mu.Lock()
+	prev := loadCPUSampler.prev
+	loadCPUSampler.prev = cur
+	loadCPUSampler.mu.Unlock()
+
+	all := cpuPercents(cur["cpu"], prev["cpu"])
+	loadCPU := &api.LoadCPU{
+		UserMode:   all[0],
+		Nice:       all[1],
+		SystemMode: all[2],
+		Idle:       all[3],
+		Iowait:     all[4],
+		Irq:        all[5],
+		Softirq:    all[6],
+		Steal:      all[7],
+		Cores:      make([]*api.LoadCore, 0, len(order)),
+	}
+	for _, name := range order {
+		core := cpuPercents(cur[name], prev[name])
+		loadCPU.Cores = append(loadCPU.Cores, &api.LoadCore{
+			Cpu:        name,
+			UserMode:   core[0],
+			Nice:       core[1],
+			SystemMode: core[2],
+			Idle:       core[3],
+			Iowait:     core[4],
+			Irq:        core[5],
+			Softirq:    core[6],
+			Steal:      core[7],
+		})
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "load_cpu_linux.go",
+		"func": "GetLoadCPU()",
+	}).Debug("")
+
+	return loadCPU, nil
+}
+
+// cpuPercents converts the jiffies spent between two samples to percents.
+func cpuPercents(cur, prev cpuTimes) [8]float64 {
+	var res [8]float64
+	total := counterDelta(cur.total(), prev.total())
+	if total == 0 {
+		return res
+	}
+	for i := range cur {
+		res[i] = float64(counterDelta(cur[i], prev[i])) * 100 / float64(total)
+	}
+
+	return res
+}
+
+// readCPUTimes returns the aggregate "cpu" line and every "cpuN" line,
+// order keeps the cpuN names as they appear in /proc/stat.
+func readCPUTimes() (map[string]cpuTimes, []string, error) {
+	file, err := os.Open(procPath("stat"))
+	if err != nil {
+		return nil, nil, err
+	}
 	defer file.Close()
 
+	res := make(map[string]cpuTimes, 9)
+	order := make([]string, 0, 8)
 	scanner := bufio.NewScanner(file)
 	scanner.Split(bufio.ScanLines)
 	for scanner.Scan() {
@@ -32,53 +120,48 @@ func GetLoadCPU() (*api.LoadCPU, error) {
 		if !strings.HasPrefix(line, "cpu") {
 			continue
 		}
-		if res, err := parseCPUStats(line); err == nil {
-			logger.Log.WithFields(logrus.Fields{
-				"file": "load_cpu_linux.go",
-				"func": "GetLoadCPU()",
-			}).Debug("")
-			return res, nil
+		name, times, err := parseCPUStats(line)
+		if err != nil {
+			return nil, nil, err
+		}
+		res[name] = times
+		if name != "cpu" {
+			order = append(order, name)
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if _, ok := res["cpu"]; !ok {
+		return nil, nil, errors.New("not data into file /proc/stat")
+	}
 
-	errorNotData := "not data into file /proc/stat"
-	logger.Log.WithFields(logrus.Fields{
-		"file": "load_cpu_linux.go",
-		"func": "GetLoadCPU()",
-	}).Error(errorNotData)
-
-	return nil, errors.New(errorNotData)
+	return res, order, nil
 }
 
-func parseCPUStats(stats string) (*api.LoadCPU, error) {
-	loadCPU := &api.LoadCPU{}
+func parseCPUStats(stats string) (string, cpuTimes, error) {
+	var times cpuTimes
 	fields := strings.Fields(stats)
-	if strings.Compare(fields[0], "cpu") != 0 {
+	if len(fields) < 5 {
 		errorNotData := "not data into file /proc/stat"
 		logger.Log.WithFields(logrus.Fields{
 			"file": "load_cpu_linux.go",
 			"func": "parseCPUStats()",
 		}).Error(errorNotData)
-		return nil, errors.New(errorNotData)
+		return "", times, errors.New(errorNotData)
 	}
-	for i := 1; i < len(fields); i++ {
-		stat, err := strconv.ParseFloat(fields[i], 64)
+	// old kernels have less columns, the rest stays zero
+	for i := 1; i < len(fields) && i <= len(times); i++ {
+		stat, err := strconv.ParseUint(fields[i], 10, 64)
 		if err != nil {
 			logger.Log.WithFields(logrus.Fields{
 				"file": "load_cpu_linux.go",
 				"func": "parseCPUStats()",
 			}).Error(err.Error())
-			return nil, err
-		}
-		switch i {
-		case 1:
-			loadCPU.UserMode = stat
-		case 3:
-			loadCPU.SystemMode = stat
-		case 4:
-			loadCPU.Idle = stat
+			return "", times, err
 		}
+		times[i-1] = stat
 	}
 
-	return loadCPU, nil
+	return fields[0], times, nil
 }
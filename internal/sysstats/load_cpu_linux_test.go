@@ -0,0 +1,36 @@
+package sysstats
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLoadCPU(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		c, err := GetLoadCPU()
+		require.Nil(t, err)
+		require.True(t, len(c.Cores) > 0)
+		sum := c.UserMode + c.Nice + c.SystemMode + c.Idle + c.Iowait + c.Irq + c.Softirq + c.Steal
+		require.InDelta(t, 100, sum, 0.01)
+	})
+}
+
+func TestCPUPercents(t *testing.T) {
+	t.Run("delta", func(t *testing.T) {
+		prev := cpuTimes{100, 0, 50, 800, 50, 0, 0, 0}
+		cur := cpuTimes{130, 0, 60, 850, 60, 0, 0, 0}
+		p := cpuPercents(cur, prev)
+		require.InDelta(t, 30, p[0], 0.001)
+		require.InDelta(t, 10, p[2], 0.001)
+		require.InDelta(t, 50, p[3], 0.001)
+		require.InDelta(t, 10, p[4], 0.001)
+	})
+
+	t.Run("no time passed", func(t *testing.T) {
+		ct := cpuTimes{1, 2, 3, 4, 5, 6, 7, 8}
+		require.Equal(t, [8]float64{}, cpuPercents(ct, ct))
+	})
+}
@@ -3,9 +3,7 @@
 package sysstats
 
 import (
-	"encoding/json"
-	"errors"
-	"os/exec"
+	"sort"
 	"strings"
 
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
@@ -13,36 +11,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-type LoadDisk struct {
-	DiskDevice string  `json:"disk_device"` //nolint:all
-	Tps        float64 `json:"tps"`         //nolint:all
-	KbRps      float64 `json:"kB_read/s"`   //nolint:all
-	KbWps      float64 `json:"kB_wrtn/s"`   //nolint:all
-}
+const sectorSize = 512
 
-type Iostat struct {
-	Sysstat struct {
-		Hosts []struct {
-			Statistics []struct {
-				Disk []LoadDisk
-			}
-		}
-	}
-}
+var loadDiskSampler = &diskSampler{}
 
+// GetLoadDisk returns the load of whole disks since the previous call.
+// The first call only takes a sample and returns no disks.
 func GetLoadDisk() ([]*api.LoadDisk, error) {
-	loadDisk := make([]*api.LoadDisk, 0, 5)
-	// Check if iostat is exists
-	iostat, err := exec.LookPath("iostat")
-	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "load_disk_linux.go",
-			"func": "GetLoadDisk()",
-		}).Error(err.Error())
-		return nil, err
-	}
-
-	out, err := exec.Command(iostat, "-d", "-k", "-o", "JSON").Output()
+	cur, prev, seconds, err := loadDiskSampler.next()
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"file": "load_disk_linux.go",
@@ -51,37 +27,27 @@ func GetLoadDisk() ([]*api.LoadDisk, error) {
 		return nil, err
 	}
 
-	iostatOut := Iostat{}
-	if err = json.Unmarshal(out, &iostatOut); err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"file": "load_disk_linux.go",
-			"func": "GetLoadDisk()",
-		}).Error(err.Error())
-		return nil, err
-	}
-
-	if len(iostatOut.Sysstat.Hosts) == 0 || len(iostatOut.Sysstat.Hosts[0].Statistics) == 0 {
-		errDev := "not devices in out of iostat command"
-		logger.Log.WithFields(logrus.Fields{
-			"file": "load_disk_linux.go",
-			"func": "GetLoadDisk()",
-		}).Error(errDev)
-		return nil, errors.New(errDev)
+	loadDisk := make([]*api.LoadDisk, 0, 5)
+	if prev == nil || seconds <= 0 {
+		return loadDisk, nil
 	}
-
-	disks := iostatOut.Sysstat.Hosts[0].Statistics[0].Disk
-	for i := range disks {
-		if strings.HasPrefix(disks[i].DiskDevice, "loop") {
+	for name, c := range cur {
+		if c.Partition || strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+			continue
+		}
+		p, ok := prev[name]
+		if !ok {
 			continue
 		}
-		loadDisk = append(loadDisk, &api.LoadDisk{
-			DiskDevice: disks[i].DiskDevice,
-			Tps:        disks[i].Tps,
-			KbRps:      disks[i].KbRps,
-			KbWps:      disks[i].KbWps,
-			KbPs:       disks[i].KbRps + disks[i].KbWps,
-		})
+		// iostat doesn't show devices without any I/O
+		if c.ReadIOs == 0 && c.WriteIOs == 0 {
+			continue
+		}
+		loadDisk = append(loadDisk, newLoadDisk(name, c, p, seconds))
 	}
+	sort.Slice(loadDisk, func(i, j int) bool {
+		return loadDisk[i].DiskDevice < loadDisk[j].DiskDevice
+	})
 
 	logger.Log.WithFields(logrus.Fields{
 		"file": "load_disk_linux.go",
@@ -90,3 +56,27 @@ func GetLoadDisk() ([]*api.LoadDisk, error) {
 
 	return loadDisk, nil
 }
+
+func newLoadDisk(name string, cur, prev diskSample, seconds float64) *api.LoadDisk {
+	ios := counterDelta(cur.ReadIOs, prev.ReadIOs) + counterDelta(cur.WriteIOs, prev.WriteIOs)
+	ticks := counterDelta(cur.ReadTicks, prev.ReadTicks) + counterDelta(cur.WriteTicks, prev.WriteTicks)
+	milliseconds := seconds * 1000
+
+	ld := &api.LoadDisk{
+		DiskDevice: name,
+		Tps:        float64(ios) / seconds,
+		KbRps:      float64(counterDelta(cur.ReadSectors, prev.ReadSectors)*sectorSize) / 1024 / seconds,
+		KbWps:      float64(counterDelta(cur.WriteSectors, prev.WriteSectors)*sectorSize) / 1024 / seconds,
+		Util:       float64(counterDelta(cur.IOTicks, prev.IOTicks)) / milliseconds * 100,
+		QueueDepth: float64(counterDelta(cur.TimeInQueue, prev.TimeInQueue)) / milliseconds,
+	}
+	ld.KbPs = ld.KbRps + ld.KbWps
+	if ios > 0 {
+		ld.Await = float64(ticks) / float64(ios)
+	}
+	if ld.Util > 100 {
+		ld.Util = 100
+	}
+
+	return ld
+}
@@ -0,0 +1,68 @@
+package sysstats
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLoadDisk(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		_, err := GetLoadDisk()
+		require.Nil(t, err)
+		ld, err := GetLoadDisk()
+		require.Nil(t, err)
+		for i := range ld {
+			require.True(t, ld[i].Util >= 0 && ld[i].Util <= 100)
+		}
+	})
+}
+
+func TestNewLoadDisk(t *testing.T) {
+	prev := diskSample{
+		ReadIOs: 1000, ReadSectors: 8000, ReadTicks: 500,
+		WriteIOs: 2000, WriteSectors: 16000, WriteTicks: 1500,
+		IOTicks: 10000, TimeInQueue: 20000,
+	}
+
+	t.Run("two samples", func(t *testing.T) {
+		// over 2 s: 100 reads of 4 KiB and 300 writes of 8 KiB
+		cur := prev
+		cur.ReadIOs += 100
+		cur.ReadSectors += 800
+		cur.ReadTicks += 200
+		cur.WriteIOs += 300
+		cur.WriteSectors += 4800
+		cur.WriteTicks += 1400
+		cur.IOTicks += 500
+		cur.TimeInQueue += 3000
+
+		ld := newLoadDisk("sda", cur, prev, 2)
+		require.Equal(t, "sda", ld.DiskDevice)
+		require.Equal(t, float64(200), ld.Tps)
+		require.Equal(t, float64(200), ld.KbRps)
+		require.Equal(t, float64(1200), ld.KbWps)
+		require.Equal(t, float64(1400), ld.KbPs)
+		// 1600 ms of requests over 400 requests
+		require.Equal(t, float64(4), ld.Await)
+		// busy 500 ms of 2000 ms
+		require.Equal(t, float64(25), ld.Util)
+		// 3000 ms of requests in queue over 2000 ms
+		require.Equal(t, 1.5, ld.QueueDepth)
+	})
+
+	t.Run("idle", func(t *testing.T) {
+		ld := newLoadDisk("sda", prev, prev, 1)
+		require.Equal(t, float64(0), ld.Tps)
+		require.Equal(t, float64(0), ld.Await)
+		require.Equal(t, float64(0), ld.Util)
+	})
+
+	t.Run("util is capped", func(t *testing.T) {
+		cur := prev
+		cur.IOTicks += 1100
+		require.Equal(t, float64(100), newLoadDisk("sda", cur, prev, 1).Util)
+	})
+}
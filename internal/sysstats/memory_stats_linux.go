@@ -0,0 +1,124 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// vmstatSampler keeps the previous /proc/vmstat sample to compute rates.
+type vmstatSampler struct {
+	mu   sync.Mutex
+	prev map[string]uint64
+	ts   time.Time
+}
+
+var memoryVMStatSampler = &vmstatSampler{}
+
+// GetMemoryStats returns memory and swap usage, paging rates are computed
+// since the previous call and are zero on the first one.
+func GetMemoryStats() (*api.MemoryStats, error) {
+	meminfo, err := readKeyValueFile(procPath("meminfo"))
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "memory_stats_linux.go",
+			"func": "GetMemoryStats()",
+		}).Error(err.Error())
+		return nil, err
+	}
+	if _, ok := meminfo["MemTotal"]; !ok {
+		errNotData := "not data into file /proc/meminfo"
+		logger.Log.WithFields(logrus.Fields{
+			"file": "memory_stats_linux.go",
+			"func": "GetMemoryStats()",
+		}).Error(errNotData)
+		return nil, errors.New(errNotData)
+	}
+	vmstat, err := readKeyValueFile(procPath("vmstat"))
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "memory_stats_linux.go",
+			"func": "GetMemoryStats()",
+		}).Error(err.Error())
+		return nil, err
+	}
+	now := time.Now()
+
+	memoryStats := &api.MemoryStats{
+		Total:     meminfo["MemTotal"],
+		Available: meminfo["MemAvailable"],
+		Buffers:   meminfo["Buffers"],
+		Cached:    meminfo["Cached"],
+		Slab:      meminfo["Slab"],
+		Dirty:     meminfo["Dirty"],
+		Writeback: meminfo["Writeback"],
+		SwapTotal: meminfo["SwapTotal"],
+		SwapFree:  meminfo["SwapFree"],
+	}
+	// kernels before 3.14 have no MemAvailable
+	if _, ok := meminfo["MemAvailable"]; !ok {
+		memoryStats.Available = meminfo["MemFree"] + meminfo["Buffers"] + meminfo["Cached"]
+	}
+	if memoryStats.Total > memoryStats.Available {
+		memoryStats.Used = memoryStats.Total - memoryStats.Available
+	}
+	if memoryStats.SwapTotal > memoryStats.SwapFree {
+		memoryStats.SwapUsed = memoryStats.SwapTotal - memoryStats.SwapFree
+	}
+
+	memoryVMStatSampler.mu.Lock()
+	prev, seconds := memoryVMStatSampler.prev, now.Sub(memoryVMStatSampler.ts).Seconds()
+	memoryVMStatSampler.prev, memoryVMStatSampler.ts = vmstat, now
+	memoryVMStatSampler.mu.Unlock()
+
+	if prev != nil && seconds > 0 {
+		memoryStats.PageIn = float64(counterDelta(vmstat["pgpgin"], prev["pgpgin"])) / seconds
+		memoryStats.PageOut = float64(counterDelta(vmstat["pgpgout"], prev["pgpgout"])) / seconds
+		memoryStats.SwapIn = float64(counterDelta(vmstat["pswpin"], prev["pswpin"])) / seconds
+		memoryStats.SwapOut = float64(counterDelta(vmstat["pswpout"], prev["pswpout"])) / seconds
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "memory_stats_linux.go",
+		"func": "GetMemoryStats()",
+	}).Debug("")
+
+	return memoryStats, nil
+}
+
+// readKeyValueFile parses files of "key value [unit]" lines like
+// /proc/meminfo ("MemTotal:  16314744 kB") and /proc/vmstat ("pgpgin 1024").
+func readKeyValueFile(fpath string) (map[string]uint64, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	res := make(map[string]uint64, 64)
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		res[strings.TrimSuffix(fields[0], ":")] = value
+	}
+
+	return res, scanner.Err()
+}
@@ -0,0 +1,83 @@
+package sysstats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMemoryStats(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		ms, err := GetMemoryStats()
+		require.Nil(t, err)
+		require.True(t, ms.Total > 0)
+		require.True(t, ms.Used <= ms.Total)
+	})
+
+	proc := hostProc
+	prev, ts := memoryVMStatSampler.prev, memoryVMStatSampler.ts
+	t.Cleanup(func() {
+		hostProc = proc
+		memoryVMStatSampler.prev, memoryVMStatSampler.ts = prev, ts
+	})
+	hostProc = t.TempDir()
+	writeProc := func(name, content string) {
+		require.Nil(t, os.WriteFile(filepath.Join(hostProc, name), []byte(content), 0o600))
+	}
+
+	t.Run("used, swap and paging", func(t *testing.T) {
+		writeProc("meminfo", "MemTotal:       16000000 kB\n"+
+			"MemFree:         1000000 kB\n"+
+			"MemAvailable:    6000000 kB\n"+
+			"Buffers:          200000 kB\n"+
+			"Cached:          3000000 kB\n"+
+			"SwapTotal:       2000000 kB\n"+
+			"SwapFree:        1500000 kB\n"+
+			"HugePages_Total:       0\n")
+		writeProc("vmstat", "pgpgin 5000\npgpgout 9000\npswpin 30\npswpout 50\n")
+		memoryVMStatSampler.prev = map[string]uint64{"pgpgin": 1000, "pgpgout": 1000, "pswpin": 10, "pswpout": 50}
+		memoryVMStatSampler.ts = time.Now().Add(-2 * time.Second)
+
+		ms, err := GetMemoryStats()
+		require.Nil(t, err)
+		require.Equal(t, uint64(16000000), ms.Total)
+		require.Equal(t, uint64(10000000), ms.Used)
+		require.Equal(t, uint64(3000000), ms.Cached)
+		require.Equal(t, uint64(500000), ms.SwapUsed)
+		require.InDelta(t, 2000, ms.PageIn, 10)
+		require.InDelta(t, 4000, ms.PageOut, 20)
+		require.InDelta(t, 10, ms.SwapIn, 0.1)
+		require.Equal(t, float64(0), ms.SwapOut)
+	})
+
+	t.Run("no MemAvailable", func(t *testing.T) {
+		writeProc("meminfo", "MemTotal: 1000 kB\nMemFree: 100 kB\nBuffers: 50 kB\nCached: 250 kB\n")
+		ms, err := GetMemoryStats()
+		require.Nil(t, err)
+		require.Equal(t, uint64(400), ms.Available)
+		require.Equal(t, uint64(600), ms.Used)
+		require.Equal(t, uint64(0), ms.SwapUsed)
+	})
+
+	t.Run("no MemTotal", func(t *testing.T) {
+		writeProc("meminfo", "MemFree: 100 kB\n")
+		_, err := GetMemoryStats()
+		require.NotNil(t, err)
+	})
+}
+
+func TestReadKeyValueFile(t *testing.T) {
+	t.Run("units and broken lines", func(t *testing.T) {
+		fpath := filepath.Join(t.TempDir(), "meminfo")
+		require.Nil(t, os.WriteFile(fpath, []byte(
+			"MemTotal:  16314744 kB\nbroken\nDirty: -\npgpgin 1024\n"), 0o600))
+		res, err := readKeyValueFile(fpath)
+		require.Nil(t, err)
+		require.Equal(t, map[string]uint64{"MemTotal": 16314744, "pgpgin": 1024}, res)
+	})
+}
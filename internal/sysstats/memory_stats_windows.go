@@ -0,0 +1,20 @@
+//go:build windows
+
+package sysstats
+
+import (
+	"errors"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+func GetMemoryStats() (*api.MemoryStats, error) {
+	err := "Not release for OS Windows"
+	logger.Log.WithFields(logrus.Fields{
+		"file": "memory_stats_windows.go",
+		"func": "GetMemoryStats()",
+	}).Error(err)
+	return nil, errors.New("Not release for OS Windows")
+}
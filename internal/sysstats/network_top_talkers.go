@@ -10,7 +10,7 @@ import (
 	"github.com/google/gopacket/layers"
 	"github.com/google/gopacket/pcap"
 	"github.com/lixoi/system_stats_daemon/config"
-	cache "github.com/lixoi/system_stats_daemon/internal/memory/lru_cache"
+	"github.com/lixoi/system_stats_daemon/internal/memory/ring"
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/sirupsen/logrus"
@@ -35,13 +35,15 @@ type NetStats struct {
 }
 
 type NetworkSniffer struct {
-	Buffer cache.Cache
+	Buffer *ring.Buffer[NetStats]
 	config config.TopTalkersConfig
 }
 
-func NewNetworkSniffer(capacity int, timeInterval cache.Key, conf config.TopTalkersConfig) NetworkSniffer {
+// NewNetworkSniffer keeps the packets of the last maxAge, capacity is the
+// initial size of the buffer.
+func NewNetworkSniffer(capacity int, maxAge time.Duration, conf config.TopTalkersConfig) NetworkSniffer {
 	return NetworkSniffer{
-		Buffer: cache.NewCache(capacity, timeInterval),
+		Buffer: ring.New[NetStats](capacity, maxAge),
 		config: conf,
 	}
 }
@@ -114,15 +116,16 @@ func (ns *NetworkSniffer) netInterfaceSniffing(ether string) {
 					stat.Type = cUDP
 				}
 			}
-			ns.Buffer.Set(cache.Key(stat.TimeStamp.UnixNano()), stat)
+			ns.Buffer.Append(stat.TimeStamp, stat)
 		}
 	}
 }
 
+// GetNetworkTopTalkers returns the traffic of the interval before the newest packet.
 func (ns *NetworkSniffer) GetNetworkTopTalkers(
-	interval cache.Key,
+	interval time.Duration,
 ) ([]*api.TopTalkersProtocol, []*api.TopTalkersTraffic, error) {
-	slice, isExist := ns.Buffer.Get(interval)
+	newest, _, isExist := ns.Buffer.Newest()
 	if !isExist {
 		err := "network dump is empty"
 		logger.Log.WithFields(logrus.Fields{
@@ -132,17 +135,17 @@ func (ns *NetworkSniffer) GetNetworkTopTalkers(
 		return nil, nil, errors.New(err)
 	}
 
+	slice := ns.Buffer.Range(newest.Add(-interval), newest)
 	allTraffic := ns.getAllTraffic(slice)
 	ttp := ns.getAllTrafficForProtocol(slice)
 	for i := 0; i < len(ttp); i++ {
 		ttp[i].Rate = (ttp[i].Bytes * 100) / allTraffic
 	}
 
-	sort.Slice(ttp, func(i, j int) bool {
-		return ttp[i].Rate < ttp[j].Rate
-	})
+	SortTopTalkersProtocol(ttp, config.SortByRate)
 
 	ttt := ns.getAllTrafficForSourse(slice)
+	SortTopTalkersTraffic(ttt, config.SortByBps)
 
 	logger.Log.WithFields(logrus.Fields{
 		"file": "network_top_talkers.go",
@@ -152,19 +155,49 @@ func (ns *NetworkSniffer) GetNetworkTopTalkers(
 	return ttp, ttt, nil
 }
 
-func (ns *NetworkSniffer) getAllTraffic(slice []interface{}) uint32 {
+// SortTopTalkersProtocol sorts the protocols by bytes or rate (the default)
+// in descending order, ties are kept in protocol order.
+func SortTopTalkersProtocol(ttp []*api.TopTalkersProtocol, key string) {
+	value := func(t *api.TopTalkersProtocol) uint32 { return t.Rate }
+	if key == config.SortByBytes {
+		value = func(t *api.TopTalkersProtocol) uint32 { return t.Bytes }
+	}
+	sort.SliceStable(ttp, func(i, j int) bool {
+		vi, vj := value(ttp[i]), value(ttp[j])
+		if vi != vj {
+			return vi > vj
+		}
+		return ttp[i].Protocol < ttp[j].Protocol
+	})
+}
+
+// SortTopTalkersTraffic sorts the flows by bps in descending order, ties are
+// kept in source order. The flows have no other sort keys.
+func SortTopTalkersTraffic(ttt []*api.TopTalkersTraffic, _ string) {
+	sort.SliceStable(ttt, func(i, j int) bool {
+		if ttt[i].Bps != ttt[j].Bps {
+			return ttt[i].Bps > ttt[j].Bps
+		}
+		if ttt[i].Source != ttt[j].Source {
+			return ttt[i].Source < ttt[j].Source
+		}
+		return ttt[i].Distination < ttt[j].Distination
+	})
+}
+
+func (ns *NetworkSniffer) getAllTraffic(slice []NetStats) uint32 {
 	var res uint32
 	for i := range slice {
-		res += slice[i].(NetStats).Length
+		res += slice[i].Length
 	}
 
 	return res
 }
 
-func (ns *NetworkSniffer) getAllTrafficForProtocol(slice []interface{}) []*api.TopTalkersProtocol {
+func (ns *NetworkSniffer) getAllTrafficForProtocol(slice []NetStats) []*api.TopTalkersProtocol {
 	mapTTP := make(map[string]*api.TopTalkersProtocol, 3)
 	for i := range slice {
-		sl := slice[i].(NetStats)
+		sl := slice[i]
 		if ns.isDisableProtocol(sl.Type) {
 			continue
 		}
@@ -182,10 +215,10 @@ func (ns *NetworkSniffer) getAllTrafficForProtocol(slice []interface{}) []*api.T
 	return maps.Values(mapTTP)
 }
 
-func (ns *NetworkSniffer) getAllTrafficForSourse(slice []interface{}) []*api.TopTalkersTraffic {
+func (ns *NetworkSniffer) getAllTrafficForSourse(slice []NetStats) []*api.TopTalkersTraffic {
 	mapTTT := make(map[string]*api.TopTalkersTraffic, 10)
 	for i := range slice {
-		sl := slice[i].(NetStats)
+		sl := slice[i]
 		if ns.isDisableProtocol(sl.Type) {
 			continue
 		}
@@ -0,0 +1,37 @@
+package sysstats
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTopTalkers(t *testing.T) {
+	t.Run("protocols", func(t *testing.T) {
+		ttp := []*api.TopTalkersProtocol{
+			{Protocol: "UDP", Bytes: 300, Rate: 30},
+			{Protocol: "TCP", Bytes: 600, Rate: 60},
+			{Protocol: "ICMP", Bytes: 300, Rate: 10},
+		}
+		SortTopTalkersProtocol(ttp, config.SortByRate)
+		require.Equal(t, []string{"TCP", "UDP", "ICMP"}, []string{ttp[0].Protocol, ttp[1].Protocol, ttp[2].Protocol})
+
+		// ties are kept in protocol order
+		SortTopTalkersProtocol(ttp, config.SortByBytes)
+		require.Equal(t, []string{"TCP", "ICMP", "UDP"}, []string{ttp[0].Protocol, ttp[1].Protocol, ttp[2].Protocol})
+	})
+
+	t.Run("traffic", func(t *testing.T) {
+		ttt := []*api.TopTalkersTraffic{
+			{Source: "10.0.0.2:80", Bps: 100},
+			{Source: "10.0.0.3:80", Bps: 500},
+			{Source: "10.0.0.1:80", Bps: 100},
+		}
+		SortTopTalkersTraffic(ttt, "")
+		require.Equal(t,
+			[]string{"10.0.0.3:80", "10.0.0.1:80", "10.0.0.2:80"},
+			[]string{ttt[0].Source, ttt[1].Source, ttt[2].Source})
+	})
+}
@@ -0,0 +1,142 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	PressureOK          = "ok"
+	PressureUnsupported = "unsupported"
+)
+
+// pressureSampler keeps the previous stall totals ("cpu/some" etc.) to compute rates.
+type pressureSampler struct {
+	mu   sync.Mutex
+	prev map[string]uint64
+	ts   time.Time
+}
+
+var psiSampler = &pressureSampler{}
+
+// GetPressure returns Pressure Stall Information of cpu, memory and io.
+// Kernels without PSI (before 4.20 or booted with psi=0) give the
+// "unsupported" status instead of an error.
+func GetPressure() (*api.Pressure, error) {
+	totals := make(map[string]uint64, 6)
+	resources := make(map[string]*api.PressureResource, 3)
+	for _, resource := range []string{"cpu", "memory", "io"} {
+		lines, err := readPressure(procPath("pressure", resource))
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.EOPNOTSUPP) {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "pressure_linux.go",
+				"func": "GetPressure()",
+			}).Debug(PressureUnsupported)
+			return &api.Pressure{Status: PressureUnsupported}, nil
+		}
+		if err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "pressure_linux.go",
+				"func": "GetPressure()",
+			}).Error(err.Error())
+			return nil, err
+		}
+		resources[resource] = &api.PressureResource{}
+		for kind, line := range lines {
+			totals[resource+"/"+kind] = uint64(line.Total)
+			switch kind {
+			case "some":
+				resources[resource].Some = line
+			case "full":
+				resources[resource].Full = line
+			}
+		}
+	}
+	now := time.Now()
+
+	psiSampler.mu.Lock()
+	prev, seconds := psiSampler.prev, now.Sub(psiSampler.ts).Seconds()
+	psiSampler.prev, psiSampler.ts = totals, now
+	psiSampler.mu.Unlock()
+
+	for resource, res := range resources {
+		for kind, line := range map[string]*api.PressureLine{"some": res.Some, "full": res.Full} {
+			if line == nil {
+				continue
+			}
+			line.Total = 0
+			if prev != nil && seconds > 0 {
+				key := resource + "/" + kind
+				line.Total = float64(counterDelta(totals[key], prev[key])) / seconds
+			}
+		}
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "pressure_linux.go",
+		"func": "GetPressure()",
+	}).Debug("")
+
+	return &api.Pressure{
+		Status: PressureOK,
+		Cpu:    resources["cpu"],
+		Memory: resources["memory"],
+		Io:     resources["io"],
+	}, nil
+}
+
+// readPressure parses lines like "some avg10=0.00 avg60=0.00 avg300=0.00 total=0",
+// Total keeps the raw cumulative stall time in microseconds.
+func readPressure(fpath string) (map[string]*api.PressureLine, error) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	res := make(map[string]*api.PressureLine, 2)
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			return nil, errors.New("couldn't parse " + fpath + " because there are less than 5 fields")
+		}
+		line := &api.PressureLine{}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			value, err := strconv.ParseFloat(kv[1], 64)
+			if err != nil {
+				return nil, err
+			}
+			switch kv[0] {
+			case "avg10":
+				line.Avg10 = value
+			case "avg60":
+				line.Avg60 = value
+			case "avg300":
+				line.Avg300 = value
+			case "total":
+				line.Total = value
+			}
+		}
+		res[fields[0]] = line
+	}
+
+	return res, scanner.Err()
+}
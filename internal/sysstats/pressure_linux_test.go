@@ -0,0 +1,40 @@
+package sysstats
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPressure(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		p, err := GetPressure()
+		require.Nil(t, err)
+		require.Contains(t, []string{PressureOK, PressureUnsupported}, p.Status)
+	})
+}
+
+func TestReadPressure(t *testing.T) {
+	t.Run("some and full", func(t *testing.T) {
+		fpath := filepath.Join(t.TempDir(), "io")
+		require.Nil(t, os.WriteFile(fpath, []byte(
+			"some avg10=1.50 avg60=0.75 avg300=0.10 total=3172930\n"+
+				"full avg10=0.00 avg60=0.01 avg300=0.00 total=2135465\n"), 0o600))
+		lines, err := readPressure(fpath)
+		require.Nil(t, err)
+		require.Equal(t, 1.5, lines["some"].Avg10)
+		require.Equal(t, 0.75, lines["some"].Avg60)
+		require.Equal(t, float64(3172930), lines["some"].Total)
+		require.Equal(t, 0.01, lines["full"].Avg60)
+	})
+
+	t.Run("no psi", func(t *testing.T) {
+		_, err := readPressure(filepath.Join(t.TempDir(), "cpu"))
+		require.True(t, errors.Is(err, os.ErrNotExist))
+	})
+}
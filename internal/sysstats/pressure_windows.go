@@ -0,0 +1,16 @@
+//go:build windows
+
+package sysstats
+
+import (
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+const (
+	PressureOK          = "ok"
+	PressureUnsupported = "unsupported"
+)
+
+func GetPressure() (*api.Pressure, error) {
+	return &api.Pressure{Status: PressureUnsupported}, nil
+}
@@ -0,0 +1,123 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// getSocketInodes maps socket inodes to the pid of the first process
+// holding them open, like netstat does for shared (forked) sockets.
+func getSocketInodes() (map[uint64]uint32, error) {
+	entries, err := os.ReadDir(procPath())
+	if err != nil {
+		return nil, err
+	}
+	inodes := make(map[uint64]uint32, 64)
+	for _, entry := range entries {
+		pid, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		fdDir := procPath(entry.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			// process is gone or belongs to another user
+			continue
+		}
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil || !strings.HasPrefix(link, "socket:[") {
+				continue
+			}
+			inode, err := strconv.ParseUint(strings.TrimSuffix(link[len("socket:["):], "]"), 10, 64)
+			if err != nil {
+				continue
+			}
+			if _, ok := inodes[inode]; !ok {
+				inodes[inode] = uint32(pid)
+			}
+		}
+	}
+
+	return inodes, nil
+}
+
+// getUserNames reads /etc/passwd, so no libc (cgo) lookup is needed.
+func getUserNames() map[string]string {
+	users := make(map[string]string, 32)
+	file, err := os.Open(rootPath("etc", "passwd"))
+	if err != nil {
+		return users
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if len(fields) < 3 {
+			continue
+		}
+		users[fields[2]] = fields[0]
+	}
+
+	return users
+}
+
+// getProcessUID returns the real uid of the process from /proc/<pid>/status.
+func getProcessUID(pid uint32) (string, error) {
+	file, err := os.Open(procPath(strconv.FormatUint(uint64(pid), 10), "status"))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "Uid:") {
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) > 1 {
+			return fields[1], nil
+		}
+	}
+
+	return "", os.ErrNotExist
+}
+
+// getProcessCommand returns the command line of the process, or its name
+// in square brackets for kernel threads (as ps does).
+func getProcessCommand(pid uint32) (string, error) {
+	dir := procPath(strconv.FormatUint(uint64(pid), 10))
+	cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline"))
+	if err != nil {
+		return "", err
+	}
+	cmdline = bytes.TrimRight(cmdline, "\x00")
+	if len(cmdline) > 0 {
+		return string(bytes.ReplaceAll(cmdline, []byte{0}, []byte{' '})), nil
+	}
+	comm, err := os.ReadFile(filepath.Join(dir, "comm"))
+	if err != nil {
+		return "", err
+	}
+
+	return "[" + strings.TrimSpace(string(comm)) + "]", nil
+}
+
+// userName resolves uid to a login name, falling back to the numeric uid.
+func userName(users map[string]string, uid string) string {
+	if name, ok := users[uid]; ok {
+		return name
+	}
+
+	return uid
+}
@@ -0,0 +1,119 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// snmpSampler keeps the previous /proc/net/{snmp,netstat} sample to compute rates.
+type snmpSampler struct {
+	mu   sync.Mutex
+	prev map[string]uint64
+	ts   time.Time
+}
+
+var protocolSampler = &snmpSampler{}
+
+// GetProtocolCounters returns TCP, UDP and ICMP health counters per second
+// since the previous call, the first call gives zero rates.
+func GetProtocolCounters() (*api.ProtocolCounters, error) {
+	cur := make(map[string]uint64, 256)
+	for _, fpath := range []string{procSelfPath("net", "snmp"), procSelfPath("net", "netstat")} {
+		if err := readSNMP(fpath, cur); err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "protocol_counters_linux.go",
+				"func": "GetProtocolCounters()",
+			}).Error(err.Error())
+			return nil, err
+		}
+	}
+	now := time.Now()
+
+	protocolSampler.mu.Lock()
+	prev, seconds := protocolSampler.prev, now.Sub(protocolSampler.ts).Seconds()
+	protocolSampler.prev, protocolSampler.ts = cur, now
+	protocolSampler.mu.Unlock()
+
+	pc := protocolRates(cur, prev, seconds)
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "protocol_counters_linux.go",
+		"func": "GetProtocolCounters()",
+	}).Debug("")
+
+	return pc, nil
+}
+
+// protocolRates returns the counters per second between the samples,
+// zero rates if there is no previous sample or no time passed.
+func protocolRates(cur, prev map[string]uint64, seconds float64) *api.ProtocolCounters {
+	pc := &api.ProtocolCounters{}
+	if prev == nil || seconds <= 0 {
+		return pc
+	}
+	rate := func(key string) float64 {
+		return float64(counterDelta(cur[key], prev[key])) / seconds
+	}
+	pc.ActiveOpens = rate("Tcp.ActiveOpens")
+	pc.PassiveOpens = rate("Tcp.PassiveOpens")
+	pc.OutSegs = rate("Tcp.OutSegs")
+	pc.RetransSegs = rate("Tcp.RetransSegs")
+	pc.OutRsts = rate("Tcp.OutRsts")
+	pc.TcpInErrors = rate("Tcp.InErrs")
+	pc.ListenOverflows = rate("TcpExt.ListenOverflows")
+	pc.ListenDrops = rate("TcpExt.ListenDrops")
+	pc.SyncookiesSent = rate("TcpExt.SyncookiesSent")
+	pc.UdpRcvbufErrors = rate("Udp.RcvbufErrors")
+	pc.UdpInErrors = rate("Udp.InErrors")
+	pc.IcmpInErrors = rate("Icmp.InErrors")
+	pc.IcmpOutErrors = rate("Icmp.OutErrors")
+	if pc.OutSegs > 0 {
+		pc.RetransRate = pc.RetransSegs * 100 / pc.OutSegs
+	}
+
+	return pc
+}
+
+// readSNMP parses the header/value line pairs of /proc/net/snmp and
+// /proc/net/netstat ("Tcp: ActiveOpens ..." then "Tcp: 12 ...") into res
+// with "Tcp.ActiveOpens" keys. Signed values (Tcp.MaxConn = -1) are skipped.
+func readSNMP(fpath string, res map[string]uint64) error {
+	file, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		header := strings.Fields(scanner.Text())
+		if !scanner.Scan() {
+			break
+		}
+		values := strings.Fields(scanner.Text())
+		if len(header) != len(values) || len(header) == 0 || header[0] != values[0] {
+			return errors.New("couldn't parse " + fpath + " because header and values don't match")
+		}
+		prefix := strings.TrimSuffix(header[0], ":")
+		for i := 1; i < len(header); i++ {
+			if value, err := strconv.ParseUint(values[i], 10, 64); err == nil {
+				res[prefix+"."+header[i]] = value
+			}
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,75 @@
+package sysstats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProtocolCounters(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		_, err := GetProtocolCounters()
+		require.Nil(t, err)
+		pc, err := GetProtocolCounters()
+		require.Nil(t, err)
+		require.True(t, pc.OutSegs >= 0)
+	})
+}
+
+func TestReadSNMP(t *testing.T) {
+	t.Run("header and values", func(t *testing.T) {
+		fpath := filepath.Join(t.TempDir(), "snmp")
+		require.Nil(t, os.WriteFile(fpath, []byte(
+			"Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens OutSegs RetransSegs\n"+
+				"Tcp: 1 200 120000 -1 12 5000 25\n"+
+				"Udp: InDatagrams InErrors RcvbufErrors\n"+
+				"Udp: 100 3 2\n"), 0o600))
+		res := make(map[string]uint64)
+		require.Nil(t, readSNMP(fpath, res))
+		require.Equal(t, uint64(12), res["Tcp.ActiveOpens"])
+		require.Equal(t, uint64(5000), res["Tcp.OutSegs"])
+		require.Equal(t, uint64(2), res["Udp.RcvbufErrors"])
+		// signed values are skipped
+		_, ok := res["Tcp.MaxConn"]
+		require.False(t, ok)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		fpath := filepath.Join(t.TempDir(), "netstat")
+		require.Nil(t, os.WriteFile(fpath, []byte(
+			"TcpExt: SyncookiesSent ListenOverflows\n"+
+				"TcpExt: 1\n"), 0o600))
+		require.NotNil(t, readSNMP(fpath, make(map[string]uint64)))
+
+		require.Nil(t, os.WriteFile(fpath, []byte(
+			"TcpExt: SyncookiesSent\n"+
+				"IpExt: 1\n"), 0o600))
+		require.NotNil(t, readSNMP(fpath, make(map[string]uint64)))
+	})
+}
+
+func TestProtocolRates(t *testing.T) {
+	t.Run("delta", func(t *testing.T) {
+		prev := map[string]uint64{"Tcp.OutSegs": 1000, "Tcp.RetransSegs": 10, "TcpExt.ListenDrops": 4}
+		cur := map[string]uint64{"Tcp.OutSegs": 3000, "Tcp.RetransSegs": 50, "TcpExt.ListenDrops": 8}
+		pc := protocolRates(cur, prev, 2)
+		require.Equal(t, float64(1000), pc.OutSegs)
+		require.Equal(t, float64(20), pc.RetransSegs)
+		require.Equal(t, float64(2), pc.RetransRate)
+		require.Equal(t, float64(2), pc.ListenDrops)
+	})
+
+	t.Run("first sample", func(t *testing.T) {
+		pc := protocolRates(map[string]uint64{"Tcp.OutSegs": 10}, nil, 1)
+		require.Equal(t, float64(0), pc.OutSegs)
+	})
+
+	t.Run("nothing sent", func(t *testing.T) {
+		counters := map[string]uint64{"Tcp.OutSegs": 10, "Tcp.RetransSegs": 1}
+		require.Equal(t, float64(0), protocolRates(counters, counters, 1).RetransRate)
+	})
+}
@@ -0,0 +1,20 @@
+//go:build windows
+
+package sysstats
+
+import (
+	"errors"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+func GetProtocolCounters() (*api.ProtocolCounters, error) {
+	err := "Not release for OS Windows"
+	logger.Log.WithFields(logrus.Fields{
+		"file": "protocol_counters_windows.go",
+		"func": "GetProtocolCounters()",
+	}).Error(err)
+	return nil, errors.New("Not release for OS Windows")
+}
@@ -0,0 +1,91 @@
+package sysstats
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// Extensions of the textfiles and their formats, files being written are
+// expected to have another name (x.prom.tmp, .x.prom) until renamed.
+var textfileFormats = map[string]string{
+	".prom": config.PluginFormatPrometheus,
+	".json": config.PluginFormatJSON,
+}
+
+// textfileCollector reads the metrics of the files of a directory.
+type textfileCollector struct {
+	dir string
+}
+
+func (tc *textfileCollector) Name() string {
+	return config.CollectorTextfile
+}
+
+func (tc *textfileCollector) Init(conf config.DumpConf) error {
+	tc.dir = conf.Textfile.Directory
+
+	return nil
+}
+
+// Collect reads every textfile, broken files are logged and skipped
+// so they don't hide the metrics of the others.
+func (tc *textfileCollector) Collect(_ context.Context, dump *api.SystemDump) error {
+	entries, err := os.ReadDir(tc.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		format, ok := textfileFormats[filepath.Ext(entry.Name())]
+		if !ok || entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		metrics, err := readTextfile(filepath.Join(tc.dir, entry.Name()), format)
+		if err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "textfile.go",
+				"func": "Collect()",
+			}).Warning(entry.Name() + ": " + err.Error())
+			continue
+		}
+		dump.CM = append(dump.CM, metrics...)
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "textfile.go",
+		"func": "Collect()",
+	}).Debug("")
+
+	return nil
+}
+
+func (tc *textfileCollector) Close() error {
+	return nil
+}
+
+func readTextfile(fpath, format string) ([]*api.CustomMetric, error) {
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := parseMetrics(format, data)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range metrics {
+		m.Source = filepath.Base(fpath)
+		m.Mtime = info.ModTime().UnixNano()
+	}
+
+	return metrics, nil
+}
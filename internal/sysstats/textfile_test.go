@@ -0,0 +1,49 @@
+package sysstats
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextfileCollector(t *testing.T) {
+	logger.Init("Debug")
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"backup.prom":     "backup_last_success_seconds 1700000000\n",
+		"queue.json":      `[{"name": "queue_depth", "labels": {"queue": "in"}, "value": 3}]`,
+		"backup.prom.tmp": "backup_last_success_seconds 1\n",
+		".raid.prom":      "raid_degraded 1\n",
+		"broken.prom":     "broken{\n",
+		"notes.txt":       "queue_depth 1\n",
+	} {
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.Nil(t, os.Chtimes(filepath.Join(dir, "backup.prom"), mtime, mtime))
+
+	c, err := NewCollector(config.CollectorTextfile)
+	require.Nil(t, err)
+	require.Nil(t, c.Init(config.DumpConf{Textfile: config.TextfileConfig{Enable: true, Directory: dir}}))
+	dump := &api.SystemDump{}
+	require.Nil(t, c.Collect(context.Background(), dump))
+
+	require.Len(t, dump.CM, 2)
+	require.Equal(t, "backup.prom", dump.CM[0].Source)
+	require.Equal(t, mtime.UnixNano(), dump.CM[0].Mtime)
+	require.Equal(t, float64(1700000000), dump.CM[0].Value)
+	require.Equal(t, "queue.json", dump.CM[1].Source)
+	require.Equal(t, "in", dump.CM[1].Labels["queue"])
+
+	t.Run("no directory", func(t *testing.T) {
+		require.Nil(t, c.Init(config.DumpConf{Textfile: config.TextfileConfig{Directory: filepath.Join(dir, "none")}}))
+		require.NotNil(t, c.Collect(context.Background(), &api.SystemDump{}))
+	})
+}
@@ -0,0 +1,38 @@
+package sysstats
+
+import (
+	"sort"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+// SortProcesses sorts processes by key in descending order,
+// ties are kept in ascending pid order.
+func SortProcesses(processes []*api.Process, key string) {
+	value := processSortValue(key)
+	sort.SliceStable(processes, func(i, j int) bool {
+		vi, vj := value(processes[i]), value(processes[j])
+		if vi != vj {
+			return vi > vj
+		}
+		return processes[i].Pid < processes[j].Pid
+	})
+}
+
+func processSortValue(key string) func(p *api.Process) float64 {
+	switch key {
+	case config.SortByRSS:
+		return func(p *api.Process) float64 { return float64(p.Rss) }
+	case config.SortByRead:
+		return func(p *api.Process) float64 { return p.ReadBps }
+	case config.SortByWrite:
+		return func(p *api.Process) float64 { return p.WriteBps }
+	case config.SortByFDs:
+		return func(p *api.Process) float64 { return float64(p.Fds) }
+	case config.SortByThreads:
+		return func(p *api.Process) float64 { return float64(p.Threads) }
+	default:
+		return func(p *api.Process) float64 { return p.Cpu }
+	}
+}
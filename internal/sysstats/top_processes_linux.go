@@ -0,0 +1,157 @@
+//go:build linux
+
+package sysstats
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// USER_HZ, it is 100 on all supported architectures.
+const clockTicks = 100
+
+var pageSizeKB = uint64(os.Getpagesize() / 1024)
+
+// procSample is what we need from /proc/<pid>/{stat,io} to compute rates.
+type procSample struct {
+	StartTime  uint64
+	CPUTicks   uint64
+	ReadBytes  uint64
+	WriteBytes uint64
+}
+
+// procSampler keeps the previous per-pid samples to compute rates.
+type procSampler struct {
+	mu   sync.Mutex
+	prev map[uint32]procSample
+	ts   time.Time
+}
+
+var topProcessesSampler = &procSampler{}
+
+// GetTopProcesses returns all the processes sorted by conf.SortBy, the K of
+// the request or the config is applied when the dump is served, after the
+// filter. CPU and I/O rates are computed since the previous call and are zero
+// on the first one.
+func GetTopProcesses(conf config.TopProcessesConfig) (*api.TopProcesses, error) {
+	entries, err := os.ReadDir(procPath())
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "top_processes_linux.go",
+			"func": "GetTopProcesses()",
+		}).Error(err.Error())
+		return nil, err
+	}
+	now := time.Now()
+
+	topProcessesSampler.mu.Lock()
+	prev, seconds := topProcessesSampler.prev, now.Sub(topProcessesSampler.ts).Seconds()
+	topProcessesSampler.mu.Unlock()
+
+	cur := make(map[uint32]procSample, len(entries))
+	processes := make([]*api.Process, 0, len(entries))
+	for _, entry := range entries {
+		pid, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		p, sample, err := readProcess(uint32(pid))
+		if err != nil {
+			// process is gone
+			continue
+		}
+		cur[p.Pid] = sample
+		if ps, ok := prev[p.Pid]; ok && ps.StartTime == sample.StartTime && seconds > 0 {
+			p.Cpu = float64(counterDelta(sample.CPUTicks, ps.CPUTicks)) / clockTicks / seconds * 100
+			p.ReadBps = float64(counterDelta(sample.ReadBytes, ps.ReadBytes)) / seconds
+			p.WriteBps = float64(counterDelta(sample.WriteBytes, ps.WriteBytes)) / seconds
+		}
+		processes = append(processes, p)
+	}
+
+	topProcessesSampler.mu.Lock()
+	topProcessesSampler.prev, topProcessesSampler.ts = cur, now
+	topProcessesSampler.mu.Unlock()
+
+	SortProcesses(processes, conf.SortBy)
+	users := getUserNames()
+	for _, p := range processes {
+		if uid, err := getProcessUID(p.Pid); err == nil {
+			p.User = userName(users, uid)
+		}
+		p.Command, _ = getProcessCommand(p.Pid)
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "top_processes_linux.go",
+		"func": "GetTopProcesses()",
+	}).Debug("")
+
+	return &api.TopProcesses{SortBy: conf.SortBy, Processes: processes}, nil
+}
+
+func readProcess(pid uint32) (*api.Process, procSample, error) {
+	dir := procPath(strconv.FormatUint(uint64(pid), 10))
+	stat, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return nil, procSample{}, err
+	}
+	p, sample, err := parseProcessStat(pid, string(stat))
+	if err != nil {
+		return nil, procSample{}, err
+	}
+	// io and fd need the same user or CAP_SYS_PTRACE
+	if io, err := readKeyValueFile(filepath.Join(dir, "io")); err == nil {
+		sample.ReadBytes, sample.WriteBytes = io["read_bytes"], io["write_bytes"]
+	}
+	if fds, err := os.ReadDir(filepath.Join(dir, "fd")); err == nil {
+		p.Fds = uint32(len(fds))
+	}
+
+	return p, sample, nil
+}
+
+// parseProcessStat parses /proc/<pid>/stat, the command may contain
+// spaces and parentheses so the fields are counted from the last ')'.
+func parseProcessStat(pid uint32, stat string) (*api.Process, procSample, error) {
+	idx := strings.LastIndexByte(stat, ')')
+	if idx < 0 {
+		return nil, procSample{}, errors.New("couldn't parse /proc/<pid>/stat")
+	}
+	fields := strings.Fields(stat[idx+1:])
+	// fields[0] is the state (3rd field of proc(5))
+	if len(fields) < 22 {
+		return nil, procSample{}, errors.New("couldn't parse /proc/<pid>/stat because there are less than 24 fields")
+	}
+	values := make(map[int]uint64, 6)
+	for _, i := range []int{1, 11, 12, 17, 19, 21} {
+		value, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, procSample{}, err
+		}
+		values[i] = value
+	}
+
+	p := &api.Process{
+		Pid:     pid,
+		Ppid:    uint32(values[1]),
+		Threads: uint32(values[17]),
+		Rss:     values[21] * pageSizeKB,
+	}
+	sample := procSample{
+		StartTime: values[19],
+		CPUTicks:  values[11] + values[12],
+	}
+
+	return p, sample, nil
+}
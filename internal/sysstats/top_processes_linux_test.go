@@ -0,0 +1,55 @@
+package sysstats
+
+import (
+	"os"
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTopProcesses(t *testing.T) {
+	logger.Init("Debug")
+	t.Run("sympe", func(t *testing.T) {
+		tp, err := GetTopProcesses(config.TopProcessesConfig{Enable: true, K: 3, SortBy: config.SortByRSS})
+		require.Nil(t, err)
+		require.Equal(t, config.SortByRSS, tp.SortBy)
+		require.True(t, len(tp.Processes) > 0)
+		// K is applied when the dump is served, so the test process is kept
+		found := false
+		for _, p := range tp.Processes {
+			found = found || p.Pid == uint32(os.Getpid())
+		}
+		require.True(t, found)
+		for i := 1; i < len(tp.Processes); i++ {
+			require.True(t, tp.Processes[i-1].Rss >= tp.Processes[i].Rss)
+		}
+	})
+}
+
+func TestParseProcessStat(t *testing.T) {
+	t.Run("command with spaces", func(t *testing.T) {
+		p, sample, err := parseProcessStat(42, "42 (my (weird) cmd) S 1 42 42 0 -1 4194560 1000 0 0 0 "+
+			"150 50 0 0 20 0 7 0 123456 10000000 256 18446744073709551615 1 1 0 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0")
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), p.Ppid)
+		require.Equal(t, uint32(7), p.Threads)
+		require.Equal(t, 256*pageSizeKB, p.Rss)
+		require.Equal(t, uint64(200), sample.CPUTicks)
+		require.Equal(t, uint64(123456), sample.StartTime)
+	})
+}
+
+func TestSortProcesses(t *testing.T) {
+	t.Run("descending and stable", func(t *testing.T) {
+		processes := []*api.Process{
+			{Pid: 3, Cpu: 10},
+			{Pid: 1, Cpu: 50},
+			{Pid: 2, Cpu: 10},
+		}
+		SortProcesses(processes, config.SortByCPU)
+		require.Equal(t, []uint32{1, 2, 3}, []uint32{processes[0].Pid, processes[1].Pid, processes[2].Pid})
+	})
+}
@@ -0,0 +1,21 @@
+//go:build windows
+
+package sysstats
+
+import (
+	"errors"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+func GetTopProcesses(conf config.TopProcessesConfig) (*api.TopProcesses, error) {
+	err := "Not release for OS Windows"
+	logger.Log.WithFields(logrus.Fields{
+		"file": "top_processes_windows.go",
+		"func": "GetTopProcesses()",
+	}).Error(err)
+	return nil, errors.New("Not release for OS Windows")
+}
@@ -0,0 +1,307 @@
+package systemdump
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// keyFields identify the elements of lists of messages across snapshots,
+// an element is aggregated over the snapshots it was seen in. Lists of
+// the other messages are taken from the newest snapshot.
+var keyFields = map[protoreflect.FullName][]protoreflect.Name{
+	"api.LoadCore":           {"cpu"},
+	"api.DiskStats":          {"device"},
+	"api.LoadDisk":           {"disk_device"},
+	"api.DiskUsage":          {"mount_point"},
+	"api.InterfaceStats":     {"name"},
+	"api.Connect":            {"state"},
+	"api.Process":            {"pid"},
+	"api.CgroupStats":        {"path"},
+	"api.CgroupIO":           {"device"},
+	"api.CustomMetric":       {"source", "name", "labels"},
+	"api.TopTalkersProtocol": {"protocol"},
+	"api.TopTalkersTraffic":  {"source", "distination", "protocol"},
+}
+
+// zeroWhenMissing are the messages counted as zero in the snapshots
+// they are missing from: no packets of a flow is no traffic.
+var zeroWhenMissing = map[protoreflect.FullName]bool{
+	"api.TopTalkersProtocol": true,
+	"api.TopTalkersTraffic":  true,
+}
+
+// lastFields are numbers taken from the newest snapshot: times, counters
+// since the start and settings.
+var lastFields = map[protoreflect.FullName]bool{
+	"api.SystemDump.timestamp":        true,
+	"api.InterfaceStats.mtu":          true,
+	"api.InterfaceStats.speed":        true,
+	"api.Process.ppid":                true,
+	"api.CgroupStats.memory_oom":      true,
+	"api.CgroupStats.memory_oom_kill": true,
+	"api.CustomMetric.mtime":          true,
+}
+
+// averageDumps averages the snapshots ordered from the oldest to the newest.
+func averageDumps(dumps []*api.SystemDump) *api.SystemDump {
+	return aggregateDumps(dumps, api.Aggregation_AGGREGATION_MEAN, nil)
+}
+
+// aggregateDumps aggregates every number of the snapshots ordered from
+// the oldest to the newest, the strings and the flags are taken from
+// the newest one. With fields only those fields of the system dump are set.
+func aggregateDumps(dumps []*api.SystemDump, agg api.Aggregation, fields map[protoreflect.Name]bool) *api.SystemDump {
+	if len(dumps) == 0 {
+		return nil
+	}
+	msgs := make([]protoreflect.Message, len(dumps))
+	for i, d := range dumps {
+		msgs[i] = d.ProtoReflect()
+	}
+	res := &api.SystemDump{}
+	fds := res.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		if fields == nil || fields[fds.Get(i).Name()] {
+			aggregateField(res.ProtoReflect(), fds.Get(i), msgs, agg, len(msgs))
+		}
+	}
+
+	return res
+}
+
+// aggregateMessage sets the fields of res from msgs ordered from the oldest,
+// total is the number of snapshots the zeroWhenMissing messages are
+// aggregated over.
+func aggregateMessage(res protoreflect.Message, msgs []protoreflect.Message, agg api.Aggregation, total int) {
+	fields := res.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		aggregateField(res, fields.Get(i), msgs, agg, total)
+	}
+}
+
+func aggregateField(
+	res protoreflect.Message,
+	fd protoreflect.FieldDescriptor,
+	msgs []protoreflect.Message,
+	agg api.Aggregation,
+	total int,
+) {
+	newest := msgs[len(msgs)-1]
+	switch {
+	case fd.IsList() && fd.Message() != nil:
+		aggregateList(res, fd, msgs, agg)
+	case fd.IsList() || fd.IsMap():
+		if newest.Has(fd) {
+			copyField(res, newest, fd)
+		}
+	case fd.Message() != nil:
+		present := make([]protoreflect.Message, 0, len(msgs))
+		for _, m := range msgs {
+			if m.Has(fd) {
+				present = append(present, m.Get(fd).Message())
+			}
+		}
+		if len(present) > 0 {
+			aggregateMessage(res.Mutable(fd).Message(), present, agg, len(present))
+		}
+	case lastFields[fd.FullName()] || !isNumber(fd.Kind()):
+		if newest.Has(fd) {
+			res.Set(fd, newest.Get(fd))
+		}
+	default:
+		values := make([]float64, 0, total)
+		for j := len(msgs); j < total; j++ {
+			values = append(values, 0)
+		}
+		for _, m := range msgs {
+			values = append(values, toFloat(m.Get(fd)))
+		}
+		if v := fromFloat(fd.Kind(), aggregateValues(values, agg)); v.IsValid() {
+			res.Set(fd, v)
+		}
+	}
+}
+
+// aggregateList aggregates the elements of the list fd with the same keys,
+// they go in the order of the newest snapshot, then of the older ones.
+func aggregateList(res protoreflect.Message, fd protoreflect.FieldDescriptor, msgs []protoreflect.Message, agg api.Aggregation) {
+	newest := msgs[len(msgs)-1]
+	keys, ok := keyFields[fd.Message().FullName()]
+	if !ok {
+		if newest.Has(fd) {
+			copyField(res, newest, fd)
+		}
+		return
+	}
+
+	groups := make(map[string][]protoreflect.Message)
+	order := make([]string, 0, newest.Get(fd).List().Len())
+	seen := make(map[string]bool)
+	for i := len(msgs) - 1; i >= 0; i-- {
+		list := msgs[i].Get(fd).List()
+		for j := 0; j < list.Len(); j++ {
+			if key := elementKey(list.Get(j).Message(), keys); !seen[key] {
+				seen[key] = true
+				order = append(order, key)
+			}
+		}
+	}
+	for _, m := range msgs {
+		list := m.Get(fd).List()
+		for j := 0; j < list.Len(); j++ {
+			key := elementKey(list.Get(j).Message(), keys)
+			groups[key] = append(groups[key], list.Get(j).Message())
+		}
+	}
+	if len(order) == 0 {
+		return
+	}
+	list := res.Mutable(fd).List()
+	for _, key := range order {
+		group := groups[key]
+		total := len(group)
+		if zeroWhenMissing[fd.Message().FullName()] {
+			total = len(msgs)
+		}
+		elem := list.NewElement()
+		aggregateMessage(elem.Message(), group, agg, total)
+		list.Append(elem)
+	}
+}
+
+// elementKey joins the values of the key fields, maps are joined sorted.
+func elementKey(m protoreflect.Message, keys []protoreflect.Name) string {
+	var b strings.Builder
+	for _, name := range keys {
+		fd := m.Descriptor().Fields().ByName(name)
+		if !fd.IsMap() {
+			b.WriteString(m.Get(fd).String() + "\x00")
+			continue
+		}
+		pairs := make([]string, 0, m.Get(fd).Map().Len())
+		m.Get(fd).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			pairs = append(pairs, k.String()+"="+v.String())
+			return true
+		})
+		sort.Strings(pairs)
+		b.WriteString(strings.Join(pairs, ",") + "\x00")
+	}
+
+	return b.String()
+}
+
+// copyField sets the list or the map fd of res to a copy of the one of src.
+func copyField(res, src protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if fd.IsMap() {
+		dst := res.Mutable(fd).Map()
+		src.Get(fd).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			dst.Set(k, v)
+			return true
+		})
+		return
+	}
+	dst := res.Mutable(fd).List()
+	list := src.Get(fd).List()
+	for i := 0; i < list.Len(); i++ {
+		v := list.Get(i)
+		if fd.Message() != nil {
+			v = protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
+		}
+		dst.Append(v)
+	}
+}
+
+// aggregateValues aggregates the values ordered from the oldest,
+// the percentiles are taken by the nearest rank.
+func aggregateValues(values []float64, agg api.Aggregation) float64 {
+	switch agg {
+	case api.Aggregation_AGGREGATION_LAST:
+		return values[len(values)-1]
+	case api.Aggregation_AGGREGATION_MEAN:
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values))
+	default:
+		sorted := append([]float64(nil), values...)
+		sort.Float64s(sorted)
+		return sorted[rank(len(sorted), agg)]
+	}
+}
+
+// percentiles of the aggregations over sorted values.
+var percentiles = map[api.Aggregation]float64{
+	api.Aggregation_AGGREGATION_MIN: 0,
+	api.Aggregation_AGGREGATION_P50: 0.5,
+	api.Aggregation_AGGREGATION_P95: 0.95,
+	api.Aggregation_AGGREGATION_P99: 0.99,
+	api.Aggregation_AGGREGATION_MAX: 1,
+}
+
+// rank returns the index of the aggregation in n sorted values.
+func rank(n int, agg api.Aggregation) int {
+	i := int(math.Ceil(percentiles[agg]*float64(n))) - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return i
+}
+
+func isNumber(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.DoubleKind, protoreflect.FloatKind,
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+
+	return false
+}
+
+func toFloat(v protoreflect.Value) float64 {
+	switch x := v.Interface().(type) {
+	case float64:
+		return x
+	case float32:
+		return float64(x)
+	case int32:
+		return float64(x)
+	case int64:
+		return float64(x)
+	case uint32:
+		return float64(x)
+	case uint64:
+		return float64(x)
+	}
+
+	return 0
+}
+
+// fromFloat returns the value of the kind, integers are rounded.
+func fromFloat(kind protoreflect.Kind, v float64) protoreflect.Value {
+	switch kind {
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(v)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(v))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(math.Round(v)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(math.Round(v)))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(math.Round(math.Max(v, 0))))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(math.Round(math.Max(v, 0))))
+	}
+
+	return protoreflect.Value{}
+}
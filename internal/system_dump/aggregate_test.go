@@ -0,0 +1,88 @@
+package systemdump
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateDumps(t *testing.T) {
+	dumps := []*api.SystemDump{
+		{
+			Timestamp: 1,
+			LD:        []*api.LoadDisk{{DiskDevice: "sda", Tps: 10}, {DiskDevice: "sdb", Tps: 100}},
+			IS:        []*api.InterfaceStats{{Name: "eth0", RxBps: 10, Mtu: 1500, Operstate: "down"}},
+			TT:        &api.TopTalkers{Ttp: []*api.TopTalkersProtocol{{Protocol: "TCP", Bytes: 300}}},
+			CS:        &api.ConnectStats{Ls: []*api.ListeningSocket{{Protocol: "tcp", Port: 22}}},
+		},
+		{
+			Timestamp: 2,
+			LD:        []*api.LoadDisk{{DiskDevice: "sdb", Tps: 200}},
+			IS:        []*api.InterfaceStats{{Name: "eth0", RxBps: 30, Mtu: 9000, Operstate: "up"}},
+			TT:        &api.TopTalkers{Ttp: []*api.TopTalkersProtocol{{Protocol: "UDP", Bytes: 10}}},
+			CS:        &api.ConnectStats{Ls: []*api.ListeningSocket{{Protocol: "tcp", Port: 80}}},
+			CM: []*api.CustomMetric{
+				{Name: "m", Labels: map[string]string{"a": "1"}, Value: 5},
+				{Name: "m", Labels: map[string]string{"a": "2"}, Value: 7},
+			},
+		},
+	}
+
+	t.Run("mean", func(t *testing.T) {
+		res := aggregateDumps(dumps, api.Aggregation_AGGREGATION_MEAN, nil)
+
+		require.Equal(t, int64(2), res.Timestamp)
+		// in the order of the newest snapshot, each over the snapshots it was seen in
+		require.Len(t, res.LD, 2)
+		require.Equal(t, "sdb", res.LD[0].DiskDevice)
+		require.Equal(t, float64(150), res.LD[0].Tps)
+		require.Equal(t, "sda", res.LD[1].DiskDevice)
+		require.Equal(t, float64(10), res.LD[1].Tps)
+		// strings and settings are the newest
+		require.Equal(t, float64(20), res.IS[0].RxBps)
+		require.Equal(t, uint32(9000), res.IS[0].Mtu)
+		require.Equal(t, "up", res.IS[0].Operstate)
+		// no traffic in a snapshot counts as zero
+		require.Len(t, res.TT.Ttp, 2)
+		require.Equal(t, "UDP", res.TT.Ttp[0].Protocol)
+		require.Equal(t, uint32(5), res.TT.Ttp[0].Bytes)
+		require.Equal(t, uint32(150), res.TT.Ttp[1].Bytes)
+		// lists without keys are the newest
+		require.Len(t, res.CS.Ls, 1)
+		require.Equal(t, uint32(80), res.CS.Ls[0].Port)
+		// metrics differ by labels
+		require.Len(t, res.CM, 2)
+		require.Equal(t, float64(7), res.CM[1].Value)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		res := aggregateDumps(dumps, api.Aggregation_AGGREGATION_MAX, nil)
+
+		require.Equal(t, float64(200), res.LD[0].Tps)
+		require.Equal(t, float64(30), res.IS[0].RxBps)
+		require.Equal(t, uint32(300), res.TT.Ttp[1].Bytes)
+	})
+
+	t.Run("source is not changed", func(t *testing.T) {
+		res := aggregateDumps(dumps[1:], api.Aggregation_AGGREGATION_MEAN, nil)
+		res.CS.Ls[0].Port = 8080
+		res.CM[0].Labels["a"] = "3"
+
+		require.Equal(t, uint32(80), dumps[1].CS.Ls[0].Port)
+		require.Equal(t, "1", dumps[1].CM[0].Labels["a"])
+	})
+
+	t.Run("percentiles", func(t *testing.T) {
+		values := make([]float64, 0, 100)
+		for i := 100; i >= 1; i-- {
+			values = append(values, float64(i))
+		}
+		require.Equal(t, float64(50), aggregateValues(values, api.Aggregation_AGGREGATION_P50))
+		require.Equal(t, float64(95), aggregateValues(values, api.Aggregation_AGGREGATION_P95))
+		require.Equal(t, float64(99), aggregateValues(values, api.Aggregation_AGGREGATION_P99))
+		require.Equal(t, float64(1), aggregateValues(values, api.Aggregation_AGGREGATION_MIN))
+		require.Equal(t, float64(1), aggregateValues(values, api.Aggregation_AGGREGATION_LAST))
+		require.Equal(t, 50.5, aggregateValues(values, api.Aggregation_AGGREGATION_MEAN))
+	})
+}
@@ -0,0 +1,135 @@
+package systemdump
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	sysstats "github.com/lixoi/system_stats_daemon/internal/sysstats"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/sirupsen/logrus"
+)
+
+var errCollectorBusy = errors.New("previous run has not finished")
+
+// collectorRunner runs a collector on its own interval and keeps the
+// section of the dump filled by its last good run.
+type collectorRunner struct {
+	collector sysstats.Collector
+	schedule  config.CollectorSchedule
+	busy      atomic.Bool
+
+	mu          sync.Mutex
+	last        *api.SystemDump
+	lastSuccess time.Time
+	lastErr     error
+	duration    time.Duration
+	// stale is set when the last run failed or timed out,
+	// last keeps the value of the run before
+	stale bool
+}
+
+func newCollectorRunner(c sysstats.Collector, schedule config.CollectorSchedule) *collectorRunner {
+	return &collectorRunner{
+		collector: c,
+		schedule:  schedule,
+	}
+}
+
+// run collects until done is closed.
+func (r *collectorRunner) run(done <-chan struct{}) {
+	ticker := time.NewTicker(r.schedule.Interval)
+	defer ticker.Stop()
+
+	r.collect()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			r.collect()
+		}
+	}
+}
+
+// collect runs the collector once and waits for it no longer than the timeout.
+// A run that missed the timeout may still finish and update the section,
+// the next runs are skipped until it does.
+func (r *collectorRunner) collect() {
+	if !r.busy.CompareAndSwap(false, true) {
+		r.fail(errCollectorBusy)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.schedule.Timeout)
+	defer cancel()
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		defer r.busy.Store(false)
+
+		section := &api.SystemDump{}
+		start := time.Now()
+		err := r.collector.Collect(ctx, section)
+		r.mu.Lock()
+		r.duration = time.Since(start)
+		r.mu.Unlock()
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		r.mu.Lock()
+		r.last, r.lastSuccess, r.lastErr, r.stale = section, start, nil, false
+		r.mu.Unlock()
+	}()
+
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		r.fail(ctx.Err())
+	}
+}
+
+func (r *collectorRunner) fail(err error) {
+	logger.Log.WithFields(logrus.Fields{
+		"file":      "collector_runner.go",
+		"func":      "collect()",
+		"collector": r.collector.Name(),
+	}).Warning(err.Error())
+
+	r.mu.Lock()
+	r.lastErr, r.stale = err, true
+	r.mu.Unlock()
+}
+
+// section returns the last good section of the dump, nil before the first one.
+func (r *collectorRunner) section() *api.SystemDump {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.last
+}
+
+// status reports the health of the collector.
+func (r *collectorRunner) status() *api.CollectorStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := &api.CollectorStatus{
+		Name:     r.collector.Name(),
+		Duration: float64(r.duration.Microseconds()) / 1000,
+		Stale:    r.stale,
+	}
+	if !r.lastSuccess.IsZero() {
+		res.LastSuccess = r.lastSuccess.UnixNano()
+	}
+	if r.lastErr != nil {
+		res.LastError = r.lastErr.Error()
+	}
+
+	return res
+}
@@ -0,0 +1,114 @@
+package systemdump
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+// slowCollector reports its call number as the dump id and hangs when told so.
+type slowCollector struct {
+	calls int
+	hang  chan struct{}
+	err   error
+}
+
+func (sc *slowCollector) Name() string                 { return "Slow" }
+func (sc *slowCollector) Init(_ config.DumpConf) error { return nil }
+func (sc *slowCollector) Close() error                 { return nil }
+func (sc *slowCollector) Collect(_ context.Context, dump *api.SystemDump) error {
+	sc.calls++
+	if sc.hang != nil {
+		<-sc.hang
+	}
+	dump.Id = string(rune('0' + sc.calls))
+	return sc.err
+}
+
+func TestCollectorRunner(t *testing.T) {
+	logger.Init("Debug")
+	schedule := config.CollectorSchedule{Interval: time.Second, Timeout: 50 * time.Millisecond}
+
+	t.Run("good run", func(t *testing.T) {
+		r := newCollectorRunner(&slowCollector{}, schedule)
+		require.Nil(t, r.section())
+		r.collect()
+		require.Equal(t, "1", r.section().Id)
+		require.False(t, r.stale)
+	})
+
+	t.Run("timeout keeps last good value", func(t *testing.T) {
+		sc := &slowCollector{}
+		r := newCollectorRunner(sc, schedule)
+		r.collect()
+
+		sc.hang = make(chan struct{})
+		start := time.Now()
+		r.collect()
+		require.Less(t, time.Since(start), time.Second)
+		require.Equal(t, "1", r.section().Id)
+		require.True(t, r.stale)
+		require.True(t, errors.Is(r.lastErr, context.DeadlineExceeded))
+
+		// the hanging run blocks the next ones
+		r.collect()
+		require.True(t, errors.Is(r.lastErr, errCollectorBusy))
+
+		close(sc.hang)
+		require.Eventually(t, func() bool { return !r.busy.Load() }, time.Second, 10*time.Millisecond)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		require.Equal(t, "2", r.last.Id)
+		require.False(t, r.stale)
+	})
+
+	t.Run("error keeps last good value", func(t *testing.T) {
+		sc := &slowCollector{}
+		r := newCollectorRunner(sc, schedule)
+		r.collect()
+		success := r.status().LastSuccess
+		require.NotZero(t, success)
+
+		sc.err = errors.New("no data")
+		r.collect()
+		require.Equal(t, "1", r.section().Id)
+		status := r.status()
+		require.Equal(t, "Slow", status.Name)
+		require.True(t, status.Stale)
+		require.Equal(t, "no data", status.LastError)
+		require.Equal(t, success, status.LastSuccess)
+	})
+
+	t.Run("never succeeded", func(t *testing.T) {
+		r := newCollectorRunner(&slowCollector{err: errors.New("no data")}, schedule)
+		r.collect()
+		require.Nil(t, r.section())
+		require.Zero(t, r.status().LastSuccess)
+	})
+}
+
+func TestCollectorStatus(t *testing.T) {
+	logger.Init("Debug")
+	cssd := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 1}})
+	schedule := config.CollectorSchedule{Interval: time.Second, Timeout: 50 * time.Millisecond}
+	cssd.runners = []*collectorRunner{newCollectorRunner(&slowCollector{}, schedule)}
+	require.Len(t, cssd.CollectorStatus(), 1)
+
+	// the collectors are closed by the dump goroutine while clients ask for the status
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			cssd.CollectorStatus()
+		}
+	}()
+	cssd.closeCollectors()
+	<-done
+	require.Empty(t, cssd.CollectorStatus())
+}
@@ -0,0 +1,200 @@
+package systemdump
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+)
+
+// rowFilter is the compiled filter of the request.
+type rowFilter struct {
+	device      string
+	portMin     uint32
+	portMax     uint32
+	protocol    string
+	source      *net.IPNet
+	destination *net.IPNet
+	user        *regexp.Regexp
+	command     *regexp.Regexp
+}
+
+// newRowFilter compiles the filter, nil if there is no filter.
+func newRowFilter(in *api.DumpFilter) (*rowFilter, error) {
+	if in == nil {
+		return nil, nil
+	}
+	f := &rowFilter{
+		device:   in.GetDevice(),
+		portMin:  in.GetPortMin(),
+		portMax:  in.GetPortMax(),
+		protocol: in.GetProtocol(),
+	}
+	if _, err := path.Match(f.device, ""); err != nil {
+		return nil, fmt.Errorf("wrong device glob %s", f.device)
+	}
+	if f.portMax > 0 && f.portMax < f.portMin {
+		return nil, fmt.Errorf("wrong port range %d-%d", f.portMin, f.portMax)
+	}
+	var err error
+	if f.source, err = parseCIDR(in.GetSource()); err != nil {
+		return nil, err
+	}
+	if f.destination, err = parseCIDR(in.GetDestination()); err != nil {
+		return nil, err
+	}
+	if f.user, err = compileRegexp(in.GetUser()); err != nil {
+		return nil, err
+	}
+	if f.command, err = compileRegexp(in.GetCommand()); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func parseCIDR(s string) (*net.IPNet, error) {
+	if s == "" {
+		return nil, nil
+	}
+	_, res, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("wrong CIDR %s", s)
+	}
+
+	return res, nil
+}
+
+func compileRegexp(s string) (*regexp.Regexp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	res, err := regexp.Compile(s)
+	if err != nil {
+		return nil, fmt.Errorf("wrong regular expression %s", s)
+	}
+
+	return res, nil
+}
+
+// CheckFilter returns an error for a filter that can't be compiled.
+func CheckFilter(in *api.DumpFilter) error {
+	_, err := newRowFilter(in)
+
+	return err
+}
+
+// apply removes the rows of the repeated sections not matching the filter.
+func (f *rowFilter) apply(dump *api.SystemDump) {
+	if f == nil {
+		return
+	}
+	dump.DS = filterRows(dump.DS, func(r *api.DiskStats) bool {
+		return f.matchDevice(r.Device)
+	})
+	dump.LD = filterRows(dump.LD, func(r *api.LoadDisk) bool {
+		return f.matchDevice(r.DiskDevice)
+	})
+	dump.DU = filterRows(dump.DU, func(r *api.DiskUsage) bool {
+		return f.matchDevice(r.FileSystem) || f.matchDevice(r.MountPoint)
+	})
+	if dump.TT != nil {
+		dump.TT.Ttp = filterRows(dump.TT.Ttp, func(r *api.TopTalkersProtocol) bool {
+			return f.matchProtocol(r.Protocol)
+		})
+		dump.TT.Ttt = filterRows(dump.TT.Ttt, f.matchTraffic)
+	}
+	if dump.CS != nil {
+		dump.CS.Ls = filterRows(dump.CS.Ls, func(r *api.ListeningSocket) bool {
+			return f.matchProtocol(r.Protocol) && f.matchPort(r.Port) &&
+				matchRegexp(f.user, r.User) && matchRegexp(f.command, r.Command)
+		})
+	}
+	if dump.TP != nil {
+		dump.TP.Processes = filterRows(dump.TP.Processes, func(r *api.Process) bool {
+			return matchRegexp(f.user, r.User) && matchRegexp(f.command, r.Command)
+		})
+	}
+}
+
+// filterRows keeps the rows in place, nil stays nil.
+func filterRows[T any](rows []T, keep func(T) bool) []T {
+	if rows == nil {
+		return nil
+	}
+	res := rows[:0]
+	for _, r := range rows {
+		if keep(r) {
+			res = append(res, r)
+		}
+	}
+
+	return res
+}
+
+func (f *rowFilter) matchDevice(device string) bool {
+	if f.device == "" {
+		return true
+	}
+	ok, _ := path.Match(f.device, device)
+
+	return ok
+}
+
+func (f *rowFilter) matchPort(port uint32) bool {
+	return port >= f.portMin && (f.portMax == 0 || port <= f.portMax)
+}
+
+// matchProtocol ignores the case and the IPv6 suffix of the listening sockets.
+func (f *rowFilter) matchProtocol(protocol string) bool {
+	return f.protocol == "" || strings.EqualFold(protocol, f.protocol) ||
+		strings.EqualFold(strings.TrimSuffix(protocol, "6"), f.protocol)
+}
+
+// matchTraffic matches the addresses "ip:port" of the flow, the port of
+// a known service is followed by its name: "10.0.0.1:443(https)". A flow
+// passes the port range if either of its ports does, ICMP has no ports.
+func (f *rowFilter) matchTraffic(r *api.TopTalkersTraffic) bool {
+	if !f.matchProtocol(r.Protocol) {
+		return false
+	}
+	srcIP, srcPort := splitAddress(r.Source)
+	dstIP, dstPort := splitAddress(r.Distination)
+	if f.source != nil && (srcIP == nil || !f.source.Contains(srcIP)) {
+		return false
+	}
+	if f.destination != nil && (dstIP == nil || !f.destination.Contains(dstIP)) {
+		return false
+	}
+	if f.portMin == 0 && f.portMax == 0 {
+		return true
+	}
+
+	return (srcPort >= 0 && f.matchPort(uint32(srcPort))) || (dstPort >= 0 && f.matchPort(uint32(dstPort)))
+}
+
+// splitAddress returns the ip and the port of the address, -1 if there is no port.
+func splitAddress(addr string) (net.IP, int) {
+	i := strings.LastIndex(addr, ":")
+	if i < 0 {
+		return net.ParseIP(addr), -1
+	}
+	digits := addr[i+1:]
+	if j := strings.IndexByte(digits, '('); j >= 0 {
+		digits = digits[:j]
+	}
+	port, err := strconv.Atoi(digits)
+	if err != nil {
+		port = -1
+	}
+
+	return net.ParseIP(addr[:i]), port
+}
+
+func matchRegexp(re *regexp.Regexp, s string) bool {
+	return re == nil || re.MatchString(s)
+}
@@ -0,0 +1,119 @@
+package systemdump
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func filterDump() *api.SystemDump {
+	return &api.SystemDump{
+		LD: []*api.LoadDisk{{DiskDevice: "sda"}, {DiskDevice: "nvme0n1"}},
+		DU: []*api.DiskUsage{
+			{FileSystem: "/dev/sda1", MountPoint: "/"},
+			{FileSystem: "tmpfs", MountPoint: "/run"},
+		},
+		TT: &api.TopTalkers{
+			Ttp: []*api.TopTalkersProtocol{{Protocol: "TCP"}, {Protocol: "UDP"}},
+			Ttt: []*api.TopTalkersTraffic{
+				{Source: "10.0.0.5:51000", Distination: "8.8.8.8:53(domain)", Protocol: "UDP"},
+				{Source: "10.0.0.5:52000", Distination: "1.1.1.1:443(https)", Protocol: "TCP"},
+				{Source: "192.168.1.2:", Distination: "10.0.0.5:", Protocol: "ICMP"},
+			},
+		},
+		CS: &api.ConnectStats{Ls: []*api.ListeningSocket{
+			{Protocol: "tcp", Port: 22, User: "root", Command: "sshd"},
+			{Protocol: "tcp6", Port: 8080, User: "www", Command: "nginx"},
+			{Protocol: "udp", Port: 53, User: "systemd-resolve", Command: "systemd-resolved"},
+		}},
+		TP: &api.TopProcesses{Processes: []*api.Process{
+			{Pid: 1, User: "root", Command: "systemd"},
+			{Pid: 2, User: "www", Command: "nginx"},
+		}},
+	}
+}
+
+func apply(t *testing.T, in *api.DumpFilter) *api.SystemDump {
+	t.Helper()
+	f, err := newRowFilter(in)
+	require.NoError(t, err)
+	dump := filterDump()
+	f.apply(dump)
+
+	return dump
+}
+
+func TestFilter(t *testing.T) {
+	t.Run("no filter", func(t *testing.T) {
+		dump := apply(t, nil)
+		require.Len(t, dump.LD, 2)
+		require.Len(t, dump.TT.Ttt, 3)
+
+		dump = apply(t, &api.DumpFilter{})
+		require.Len(t, dump.DU, 2)
+		require.Len(t, dump.CS.Ls, 3)
+	})
+
+	t.Run("device", func(t *testing.T) {
+		dump := apply(t, &api.DumpFilter{Device: "sd*"})
+		require.Equal(t, []*api.LoadDisk{{DiskDevice: "sda"}}, dump.LD)
+		require.Empty(t, dump.DU)
+
+		dump = apply(t, &api.DumpFilter{Device: "/dev/sd*"})
+		require.Len(t, dump.DU, 1)
+		require.Equal(t, "/", dump.DU[0].MountPoint)
+
+		dump = apply(t, &api.DumpFilter{Device: "/run"})
+		require.Len(t, dump.DU, 1)
+		require.Equal(t, "tmpfs", dump.DU[0].FileSystem)
+	})
+
+	t.Run("ports and protocol", func(t *testing.T) {
+		dump := apply(t, &api.DumpFilter{PortMin: 1, PortMax: 1024})
+		require.Len(t, dump.CS.Ls, 2)
+		require.Len(t, dump.TT.Ttt, 2)
+		require.Len(t, dump.TT.Ttp, 2)
+
+		dump = apply(t, &api.DumpFilter{PortMin: 8000})
+		require.Equal(t, uint32(8080), dump.CS.Ls[0].Port)
+		require.Len(t, dump.TT.Ttt, 2)
+
+		dump = apply(t, &api.DumpFilter{Protocol: "tcp"})
+		require.Len(t, dump.CS.Ls, 2)
+		require.Equal(t, []*api.TopTalkersProtocol{{Protocol: "TCP"}}, dump.TT.Ttp)
+		require.Len(t, dump.TT.Ttt, 1)
+		require.Equal(t, "TCP", dump.TT.Ttt[0].Protocol)
+	})
+
+	t.Run("cidr", func(t *testing.T) {
+		dump := apply(t, &api.DumpFilter{Source: "10.0.0.0/8"})
+		require.Len(t, dump.TT.Ttt, 2)
+
+		dump = apply(t, &api.DumpFilter{Source: "10.0.0.0/8", Destination: "8.8.8.0/24"})
+		require.Len(t, dump.TT.Ttt, 1)
+		require.Equal(t, "UDP", dump.TT.Ttt[0].Protocol)
+	})
+
+	t.Run("user and command", func(t *testing.T) {
+		dump := apply(t, &api.DumpFilter{User: "^root$"})
+		require.Len(t, dump.CS.Ls, 1)
+		require.Equal(t, "sshd", dump.CS.Ls[0].Command)
+		require.Len(t, dump.TP.Processes, 1)
+
+		dump = apply(t, &api.DumpFilter{Command: "nginx|sshd", User: "www"})
+		require.Len(t, dump.CS.Ls, 1)
+		require.Equal(t, uint32(2), dump.TP.Processes[0].Pid)
+		// the other sections aren't filtered by the user
+		require.Len(t, dump.LD, 2)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		require.NoError(t, CheckFilter(nil))
+		require.NoError(t, CheckFilter(&api.DumpFilter{Device: "sd?", Source: "fd00::/8", User: ".*"}))
+		require.EqualError(t, CheckFilter(&api.DumpFilter{Device: "sd["}), "wrong device glob sd[")
+		require.EqualError(t, CheckFilter(&api.DumpFilter{PortMin: 10, PortMax: 5}), "wrong port range 10-5")
+		require.EqualError(t, CheckFilter(&api.DumpFilter{Destination: "10.0.0.1"}), "wrong CIDR 10.0.0.1")
+		require.EqualError(t, CheckFilter(&api.DumpFilter{Command: "("}), "wrong regular expression (")
+	})
+}
@@ -0,0 +1,57 @@
+package systemdump
+
+import (
+	"fmt"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// sectionFields are the fields of the system dump by the section names.
+var sectionFields = map[string]protoreflect.Name{
+	config.CollectorLoadAverage:       "l_a",
+	config.CollectorLoadCPU:           "l_c",
+	config.CollectorDiskStats:         "d_s",
+	config.CollectorLoadDisks:         "l_d",
+	config.CollectorDiskUsage:         "d_u",
+	config.CollectorNetworkTopTalkers: "t_t",
+	config.CollectorConnectStats:      "c_s",
+	config.CollectorMemory:            "m_s",
+	config.CollectorPressure:          "p_s_i",
+	config.CollectorInterfaceStats:    "i_s",
+	config.CollectorProtocolCounters:  "p_c",
+	config.CollectorTopProcesses:      "t_p",
+	config.CollectorCgroups:           "c_g",
+	config.SectionCustomMetrics:       "c_m",
+	config.SectionCollectorStatus:     "c_st",
+}
+
+// CheckSections returns an error for a section that is unknown or disabled in DumpFields.
+func (cssd *CacheSysStatDumps) CheckSections(sections []string) error {
+	enabled := cssd.config.EnabledSections()
+	for _, name := range sections {
+		if _, ok := sectionFields[name]; !ok {
+			return fmt.Errorf("unknown section %s", name)
+		}
+		if !slices.Contains(enabled, name) {
+			return fmt.Errorf("section %s is disabled in DumpFields", name)
+		}
+	}
+
+	return nil
+}
+
+// sectionMask returns the fields of the system dump of the sections and
+// its timestamp, nil for all the fields.
+func sectionMask(sections []string) map[protoreflect.Name]bool {
+	if len(sections) == 0 {
+		return nil
+	}
+	res := map[protoreflect.Name]bool{"timestamp": true}
+	for _, name := range sections {
+		res[sectionFields[name]] = true
+	}
+
+	return res
+}
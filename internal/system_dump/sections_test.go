@@ -0,0 +1,54 @@
+package systemdump
+
+import (
+	"testing"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSections(t *testing.T) {
+	t.Run("fields exist", func(t *testing.T) {
+		fields := (&api.SystemDump{}).ProtoReflect().Descriptor().Fields()
+		for name, field := range sectionFields {
+			require.NotNil(t, fields.ByName(field), name)
+		}
+	})
+
+	t.Run("check", func(t *testing.T) {
+		cssd := NewCacheSysStatDumps(config.Config{
+			Server: config.ServerConf{Capacity: 10},
+			DumpFields: config.DumpConf{
+				LoadCPU:           true,
+				NetworkTopTalkers: config.TopTalkersConfig{Enable: true},
+				Plugins:           []config.PluginConfig{{Name: "raid"}},
+			},
+		})
+
+		require.NoError(t, cssd.CheckSections(nil))
+		require.NoError(t, cssd.CheckSections([]string{"LoadCPU", "NetworkTopTalkers", "CustomMetrics", "CollectorStatus"}))
+		require.EqualError(t, cssd.CheckSections([]string{"LoadCPU", "Memory"}), "section Memory is disabled in DumpFields")
+		require.EqualError(t, cssd.CheckSections([]string{"Plugin:raid"}), "unknown section Plugin:raid")
+		require.EqualError(t, cssd.CheckSections([]string{"l_c"}), "unknown section l_c")
+	})
+
+	t.Run("mask", func(t *testing.T) {
+		dumps := []*api.SystemDump{{
+			Timestamp: 1,
+			LC:        &api.LoadCPU{UserMode: 10},
+			MS:        &api.MemoryStats{Total: 100},
+			TT:        &api.TopTalkers{Ttp: []*api.TopTalkersProtocol{{Protocol: "TCP"}}},
+		}}
+
+		res := aggregateDumps(dumps, api.Aggregation_AGGREGATION_MEAN, sectionMask([]string{"NetworkTopTalkers"}))
+		require.Equal(t, int64(1), res.Timestamp)
+		require.Nil(t, res.LC)
+		require.Nil(t, res.MS)
+		require.Len(t, res.TT.Ttp, 1)
+
+		res = aggregateDumps(dumps, api.Aggregation_AGGREGATION_MEAN, sectionMask(nil))
+		require.NotNil(t, res.LC)
+		require.NotNil(t, res.MS)
+	})
+}
@@ -1,84 +1,130 @@
 package systemdump
 
 import (
-	"sort"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/lixoi/system_stats_daemon/config"
-	lrucache "github.com/lixoi/system_stats_daemon/internal/memory/lru_cache"
+	"github.com/lixoi/system_stats_daemon/internal/memory/ring"
 	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/internal/storage"
 	sysstats "github.com/lixoi/system_stats_daemon/internal/sysstats"
 	"github.com/lixoi/system_stats_daemon/logger"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 )
 
 type CacheSysStatDumps struct {
-	mu     sync.Mutex
-	Buffer lrucache.Cache
+	Buffer *ring.Buffer[*api.SystemDump]
 	config config.DumpConf
+	// mu guards runners read by CollectorStatus
+	mu      sync.Mutex
+	runners []*collectorRunner
+	done    chan struct{}
+	// resizeMu serialises the resizing of the cache and the loading of
+	// the stored history into it, it guards requests and history
+	resizeMu sync.Mutex
+	requests []int // M of the active requests
+	capacity int   // of the cache without requests
+	// on-disk history, nil if disabled
+	storageConf config.StorageConfig
+	history     *storage.History
 }
 
+// historyPoints is the least number of rollups a window is averaged over.
+const historyPoints = 60
+
 func NewCacheSysStatDumps(conf config.Config) CacheSysStatDumps {
+	sysstats.SetHostPaths(conf.HostProc, conf.HostSys, conf.HostRoot)
 	return CacheSysStatDumps{
-		Buffer: lrucache.NewCache(conf.Server.Capacity, 0),
-		config: conf.DumpFields,
+		Buffer:      ring.New[*api.SystemDump](conf.Server.Capacity, 0),
+		config:      conf.DumpFields,
+		done:        make(chan struct{}),
+		requests:    make([]int, 0, 10),
+		capacity:    conf.Server.Capacity,
+		storageConf: conf.Storage,
 	}
 }
 
-func (cssd *CacheSysStatDumps) StartDump() error { //nolint:all
+// StartDump starts every enabled collector on its own interval and saves
+// a snapshot of their last good sections every second.
+func (cssd *CacheSysStatDumps) StartDump() error {
 	logger.Log.WithFields(logrus.Fields{
 		"file": "sniffer.go",
 		"func": "Start()",
 	}).Debug("start system dump sniffer")
-	cicle, _ := time.ParseDuration("1s")
-	ticker := time.NewTicker(1 * time.Second)
-	ns := sysstats.NetworkSniffer{}
-
-	if cssd.config.NetworkTopTalkers.Enable {
-		interval, _ := time.ParseDuration("1.5s")
-		ns = sysstats.NewNetworkSniffer(
-			int(interval.Milliseconds()),
-			lrucache.Key(interval.Nanoseconds()),
-			cssd.config.NetworkTopTalkers,
-		)
-		ns.Start()
+
+	if cssd.storageConf.Enable {
+		h, err := storage.OpenHistory(cssd.storageConf, averageDumps)
+		if err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "sniffer.go",
+				"func": "StartDump()",
+			}).Error("storage: " + err.Error())
+			return err
+		}
+		cssd.resizeMu.Lock()
+		cssd.history = h
+		cssd.loadHistory()
+		cssd.resizeMu.Unlock()
+	}
+	for _, name := range cssd.config.EnabledCollectors() {
+		c, err := sysstats.NewCollector(name)
+		if err == nil {
+			err = c.Init(cssd.config)
+		}
+		if err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file": "sniffer.go",
+				"func": "StartDump()",
+			}).Error(name + ": " + err.Error())
+			cssd.closeCollectors()
+			cssd.closeStorage()
+			return err
+		}
+		cssd.mu.Lock()
+		cssd.runners = append(cssd.runners, newCollectorRunner(c, cssd.config.CollectorSchedule(name)))
+		cssd.mu.Unlock()
+	}
+
+	var wg sync.WaitGroup
+	for _, r := range cssd.runners {
+		wg.Add(1)
+		go func(r *collectorRunner) {
+			defer wg.Done()
+			r.run(cssd.done)
+		}(r)
 	}
+	ticker := time.NewTicker(config.DefaultCollectorInterval)
 	go func() {
-		for { //nolint:all
+		defer ticker.Stop()
+		for {
 			select {
-			case <-ticker.C:
-				dump := api.SystemDump{}
-				// init system stats
-				if cssd.config.LoadAverage {
-					dump.LA, _ = sysstats.GetLoadAvg()
-				}
-				if cssd.config.LoadCPU {
-					dump.LC, _ = sysstats.GetLoadCPU()
-				}
-				if cssd.config.DiskStats {
-					dump.DS, _ = sysstats.GetDiskStats()
-				}
-				if cssd.config.LoadDisks {
-					dump.LD, _ = sysstats.GetLoadDisk()
-				}
-				if cssd.config.DiskUsage {
-					dump.DU, _ = sysstats.GetDiskUsage()
-				}
-				if cssd.config.ConnectStats {
-					dump.CS = &api.ConnectStats{}
-					dump.CS.Conn, _ = sysstats.GetConnects()
-					dump.CS.Ls, _ = sysstats.GetListeningSockets()
-				}
-				dump.TT = &api.TopTalkers{}
-				if cssd.config.NetworkTopTalkers.Enable {
-					dump.TT.Ttp, dump.TT.Ttt, _ = ns.GetNetworkTopTalkers(lrucache.Key(cicle.Nanoseconds()))
+			case <-cssd.done:
+				wg.Wait()
+				cssd.closeCollectors()
+				cssd.closeStorage()
+				return
+			case ts := <-ticker.C:
+				dump := &api.SystemDump{Timestamp: ts.UnixNano()}
+				for _, r := range cssd.runners {
+					if section := r.section(); section != nil {
+						proto.Merge(dump, section)
+					}
+					dump.CSt = append(dump.CSt, r.status())
 				}
 				// save in cache
-				cssd.mu.Lock()
-				cssd.Buffer.Set(lrucache.Key(time.Now().UnixNano()), dump) //nolint:all
-				cssd.mu.Unlock()
+				cssd.Buffer.Append(ts, dump)
+				if h := cssd.storedHistory(); h != nil {
+					if err := h.Append(ts, dump); err != nil {
+						logger.Log.WithFields(logrus.Fields{
+							"file": "sniffer.go",
+							"func": "StartDump()",
+						}).Error("storage: " + err.Error())
+					}
+				}
 			}
 		}
 	}()
@@ -86,156 +132,381 @@ func (cssd *CacheSysStatDumps) StartDump() error { //nolint:all
 	return nil
 }
 
+// CollectorStatus returns the current status of every running collector.
+func (cssd *CacheSysStatDumps) CollectorStatus() []*api.CollectorStatus {
+	cssd.mu.Lock()
+	runners := cssd.runners
+	cssd.mu.Unlock()
+
+	res := make([]*api.CollectorStatus, 0, len(runners))
+	for _, r := range runners {
+		res = append(res, r.status())
+	}
+
+	return res
+}
+
+// StopDump stops collecting and closes the collectors.
+func (cssd *CacheSysStatDumps) StopDump() {
+	close(cssd.done)
+}
+
+func (cssd *CacheSysStatDumps) closeCollectors() {
+	cssd.mu.Lock()
+	runners := cssd.runners
+	cssd.runners = nil
+	cssd.mu.Unlock()
+
+	for _, r := range runners {
+		if err := r.collector.Close(); err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"file":      "sniffer.go",
+				"func":      "closeCollectors()",
+				"collector": r.collector.Name(),
+			}).Error(err.Error())
+		}
+	}
+}
+
+func (cssd *CacheSysStatDumps) closeStorage() {
+	cssd.resizeMu.Lock()
+	defer cssd.resizeMu.Unlock()
+
+	if cssd.history == nil {
+		return
+	}
+	if err := cssd.history.Close(); err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "sniffer.go",
+			"func": "closeStorage()",
+		}).Error(err.Error())
+	}
+	cssd.history = nil
+}
+
+// storedHistory returns the on-disk history, nil if it is disabled or closed.
+func (cssd *CacheSysStatDumps) storedHistory() *storage.History {
+	cssd.resizeMu.Lock()
+	defer cssd.resizeMu.Unlock()
+
+	return cssd.history
+}
+
+// AddRequest counts a request over m seconds and grows the cache for it,
+// it returns the number of the active requests.
+func (cssd *CacheSysStatDumps) AddRequest(m int) int {
+	cssd.resizeMu.Lock()
+	defer cssd.resizeMu.Unlock()
+
+	cssd.requests = append(cssd.requests, m)
+	if m > cssd.Buffer.Cap() {
+		cssd.changeSizeCache(m)
+
+		logger.Log.WithFields(logrus.Fields{
+			"file": "sniffer.go",
+			"func": "AddRequest()",
+		}).Debug("reallocate cache, current size = " + strconv.Itoa(cssd.Buffer.Cap()))
+	}
+
+	return len(cssd.requests)
+}
+
+// DelRequest uncounts a request over m seconds and shrinks the cache to
+// the largest M of the rest or to the configured capacity, it returns
+// the number of the active requests.
+func (cssd *CacheSysStatDumps) DelRequest(m int) int {
+	cssd.resizeMu.Lock()
+	defer cssd.resizeMu.Unlock()
+
+	if i := slices.Index(cssd.requests, m); i >= 0 {
+		cssd.requests = slices.Delete(cssd.requests, i, i+1)
+	}
+	if len(cssd.requests) == 0 {
+		cssd.changeSizeCache(cssd.capacity)
+		return 0
+	}
+	if m >= cssd.Buffer.Cap() {
+		largest := cssd.requests[0]
+		for _, r := range cssd.requests {
+			if r > largest {
+				largest = r
+			}
+		}
+		cssd.changeSizeCache(largest)
+	}
+
+	return len(cssd.requests)
+}
+
+// ChangeSizeCache resizes the cache, a larger cache is filled with
+// the stored history. The cache is not larger than the windows averaged
+// over snapshots, see rollupStep.
 func (cssd *CacheSysStatDumps) ChangeSizeCache(capacity int) {
-	cssd.Buffer.ResizeCacheOfCap(capacity)
+	cssd.resizeMu.Lock()
+	defer cssd.resizeMu.Unlock()
+
+	cssd.changeSizeCache(capacity)
+}
+
+// changeSizeCache is ChangeSizeCache with resizeMu held.
+func (cssd *CacheSysStatDumps) changeSizeCache(capacity int) {
+	if cssd.history != nil && cssd.history.Finest() > 0 {
+		if limit := int(cssd.history.Finest()*historyPoints/time.Second) - 1; capacity > limit {
+			capacity = limit
+		}
+	}
+	grow := capacity > cssd.Buffer.Cap()
+	cssd.Buffer.Resize(capacity)
+	if grow {
+		cssd.loadHistory()
+	}
+}
+
+// loadHistory fills the free places of the cache with the stored snapshots
+// taken over the seconds before the oldest cached one, resizeMu must be held.
+func (cssd *CacheSysStatDumps) loadHistory() {
+	if cssd.history == nil {
+		return
+	}
+	missing := cssd.Buffer.Cap() - cssd.Buffer.Len()
+	if missing <= 0 {
+		return
+	}
+	to := time.Now()
+	if oldest, _, ok := cssd.Buffer.Oldest(); ok {
+		to = oldest.Add(-time.Nanosecond)
+	}
+	// one more second for the jitter of the ticker
+	from := to.Add(-time.Duration(missing+1) * config.DefaultCollectorInterval)
+
+	type stored struct {
+		ts   time.Time
+		dump *api.SystemDump
+	}
+	dumps := make([]stored, 0, missing)
+	err := cssd.history.Range(from, to, 0, func(ts time.Time, dump *api.SystemDump) error {
+		dumps = append(dumps, stored{ts: ts, dump: dump})
+		return nil
+	})
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "sniffer.go",
+			"func": "loadHistory()",
+		}).Error(err.Error())
+	}
+	if len(dumps) > missing {
+		dumps = dumps[len(dumps)-missing:]
+	}
+	// from the oldest, each one is moved only past the cached snapshots
+	for _, d := range dumps {
+		cssd.Buffer.Append(d.ts, d.dump)
+	}
+
+	logger.Log.WithFields(logrus.Fields{
+		"file": "sniffer.go",
+		"func": "loadHistory()",
+	}).Debug("loaded " + strconv.Itoa(len(dumps)) + " snapshots from storage")
 }
 
-func (cssd *CacheSysStatDumps) GetSysStatDumpOver(m uint32) *api.SystemDump {
+// GetSysStatDumpOver returns the aggregate of the snapshots of the last M seconds
+// and the aggregation used. A window averaged over rollups is always MEAN:
+// the rollups keep only the means of their intervals, a spike within one
+// is lost.
+func (cssd *CacheSysStatDumps) GetSysStatDumpOver(in *api.GetSystemDumpRequest) (*api.SystemDump, api.Aggregation) {
+	m := in.GetM()
 	logger.Log.WithFields(logrus.Fields{
 		"file": "snigger.go",
 		"func": "GetSysStatDumpOver()",
 	}).Debug("collect dump to send to client")
 
-	cssd.mu.Lock()
-	defer cssd.mu.Unlock()
-
-	interval, _ := time.ParseDuration(strconv.FormatUint(uint64(m), 10) + "s")
-	slice, ok := cssd.Buffer.Get(lrucache.Key(interval.Nanoseconds()))
-	if !ok || len(slice) == 0 {
-		return nil
+	// a snapshot is taken every second
+	if m == 0 {
+		m = 1
+	}
+	agg := in.GetAggregation()
+	var res *api.SystemDump
+	if step := cssd.rollupStep(m); step > 0 {
+		agg = api.Aggregation_AGGREGATION_MEAN
+		res = aggregateDumps(cssd.rollupsOver(m, step), agg, sectionMask(in.GetSections()))
+	} else {
+		res = aggregateDumps(cssd.Buffer.Last(int(m)), agg, sectionMask(in.GetSections()))
 	}
-	if len(slice) < int(m) {
-		m = uint32(len(slice))
+	if res == nil {
+		return nil, agg
 	}
+	filter, err := newRowFilter(in.GetFilter())
+	if err != nil {
+		// checked by CheckFilter before
+		logger.Log.WithFields(logrus.Fields{
+			"file": "sniffer.go",
+			"func": "GetSysStatDumpOver()",
+		}).Error(err.Error())
+	}
+	filter.apply(res)
+	cssd.cutRows(res, in)
 
-	res := cssd.copySystemDump(slice[0].(api.SystemDump)) //nolint:all
+	return res, agg
+}
 
-	for idx := range slice[1:] {
-		dump := slice[idx].(api.SystemDump) //nolint:all
-		// load disk
-		for j := 0; j < len(dump.LD) && len(dump.LD) == len(res.LD); j++ {
-			res.LD[j].Tps += dump.LD[j].Tps
-			res.LD[j].KbPs += dump.LD[j].KbPs
-			res.LD[j].KbRps += dump.LD[j].KbRps
-			res.LD[j].KbWps += dump.LD[j].KbWps
-		}
-		// top talkers protocol (TTP)
-		for j := 0; j < len(dump.TT.Ttp); j++ {
-			if index := sort.Search(len(res.TT.Ttp), func(i int) bool {
-				return res.TT.Ttp[i].Protocol == dump.TT.Ttp[j].Protocol
-			}); index == len(res.TT.Ttp) {
-				res.TT.Ttp = append(res.TT.Ttp, &api.TopTalkersProtocol{
-					Protocol: dump.TT.Ttp[j].Protocol,
-					Bytes:    dump.TT.Ttp[j].Bytes,
-					Rate:     dump.TT.Ttp[j].Rate,
-				})
-			} else {
-				res.TT.Ttp[index].Bytes += dump.TT.Ttp[j].Bytes
-				res.TT.Ttp[index].Rate += dump.TT.Ttp[j].Rate
+// GetSysStatDumpHistory sends the snapshots taken in [from, to] from the oldest,
+// with a step they are averaged over the intervals of step stamped with their
+// start. The storage is read if it is enabled, the cache otherwise.
+func (cssd *CacheSysStatDumps) GetSysStatDumpHistory(
+	in *api.GetSystemDumpHistoryRequest,
+	send func(dump *api.SystemDump) error,
+) error {
+	logger.Log.WithFields(logrus.Fields{
+		"file": "sniffer.go",
+		"func": "GetSysStatDumpHistory()",
+	}).Debug("collect history to send to client")
+
+	from := time.Unix(0, in.GetFrom())
+	to := time.Now()
+	if in.GetTo() > 0 {
+		to = time.Unix(0, in.GetTo())
+	}
+	r := &resampler{
+		step: time.Duration(in.GetStep()) * time.Second,
+		send: func(dump *api.SystemDump) error {
+			if dump.TP != nil {
+				cssd.cutTopProcesses(dump.TP, nil)
 			}
+			return send(dump)
+		},
+	}
+	if h := cssd.storedHistory(); h != nil {
+		if err := h.Range(from, to, r.step, r.add); err != nil {
+			return err
 		}
-		// top talkers traffic (TTT)
-		for j := 0; j < len(dump.TT.Ttt); j++ {
-			if index := sort.Search(len(res.TT.Ttt), func(i int) bool {
-				return res.TT.Ttt[i].Source == dump.TT.Ttt[j].Source
-			}); index == len(res.TT.Ttt) {
-				res.TT.Ttt = append(res.TT.Ttt, &api.TopTalkersTraffic{
-					Source:      dump.TT.Ttt[j].Source,
-					Distination: dump.TT.Ttt[j].Distination,
-					Protocol:    dump.TT.Ttt[j].Protocol,
-					Bps:         dump.TT.Ttt[j].Bps,
-				})
-			} else {
-				res.TT.Ttt[index].Bps += dump.TT.Ttt[j].Bps
+	} else {
+		for _, dump := range cssd.Buffer.Range(from, to) {
+			if err := r.add(time.Unix(0, dump.Timestamp), dump); err != nil {
+				return err
 			}
 		}
 	}
-	// calculate average
-	for i := 0; i < len(res.LD); i++ { // DL
-		res.LD[i].Tps /= float64(m)
-		res.LD[i].KbPs /= float64(m)
-		res.LD[i].KbRps /= float64(m)
-		res.LD[i].KbWps /= float64(m)
+
+	return r.flush()
+}
+
+// resampler averages the points over the intervals of step.
+type resampler struct {
+	step    time.Duration
+	bucket  time.Time
+	pending []*api.SystemDump
+	send    func(dump *api.SystemDump) error
+}
+
+func (r *resampler) add(ts time.Time, dump *api.SystemDump) error {
+	if r.step <= 0 {
+		// the cached snapshots are shared
+		return r.send(proto.Clone(dump).(*api.SystemDump))
 	}
-	for i := 0; i < len(res.TT.Ttp); i++ { // TTP
-		res.TT.Ttp[i].Bytes /= m
-		res.TT.Ttp[i].Rate /= m
+	if bucket := ts.Truncate(r.step); !bucket.Equal(r.bucket) {
+		if err := r.flush(); err != nil {
+			return err
+		}
+		r.bucket = bucket
 	}
-	for i := 0; i < len(res.TT.Ttt); i++ { // TTT
-		res.TT.Ttt[i].Bps /= m
+	r.pending = append(r.pending, dump)
+
+	return nil
+}
+
+func (r *resampler) flush() error {
+	if len(r.pending) == 0 {
+		return nil
 	}
+	res := averageDumps(r.pending)
+	res.Timestamp = r.bucket.UnixNano()
+	r.pending = nil
 
-	return res
+	return r.send(res)
 }
 
-func (cssd *CacheSysStatDumps) copySystemDump(sysDump api.SystemDump) *api.SystemDump { //nolint:all
-	res := &api.SystemDump{}
-	res.LA = &api.LoadAverage{
-		AvgOneMin:     sysDump.LA.AvgOneMin,
-		AvgFiveMin:    sysDump.LA.AvgFiveMin,
-		AvgFifteenMin: sysDump.LA.AvgFifteenMin,
-	}
-	res.LC = &api.LoadCPU{
-		UserMode:   sysDump.LC.UserMode,
-		SystemMode: sysDump.LC.SystemMode,
-		Idle:       sysDump.LC.Idle,
-	}
-	res.LD = make([]*api.LoadDisk, len(sysDump.LD))
-	for i := range res.LD {
-		res.LD[i] = &api.LoadDisk{
-			DiskDevice: sysDump.LD[i].DiskDevice,
-			Tps:        sysDump.LD[i].Tps,
-			KbRps:      sysDump.LD[i].KbRps,
-			KbWps:      sysDump.LD[i].KbWps,
-			KbPs:       sysDump.LD[i].KbPs,
-		}
+// rollupStep returns the resolution of the rollups a window of m seconds is
+// averaged over, they give at least historyPoints values. Zero means
+// the window is averaged over the snapshots of the cache.
+func (cssd *CacheSysStatDumps) rollupStep(m uint32) time.Duration {
+	h := cssd.storedHistory()
+	if h == nil {
+		return 0
 	}
-	res.DU = make([]*api.DiskUsage, len(sysDump.DU))
-	for i := range res.DU {
-		res.DU[i] = &api.DiskUsage{
-			FileSystem: sysDump.DU[i].FileSystem,
-			Used:       sysDump.DU[i].Used,
-			Use:        sysDump.DU[i].Use,
-			Iused:      sysDump.DU[i].Iused,
-			Iuse:       sysDump.DU[i].Iuse,
-		}
+
+	return h.Resolution(time.Duration(m) * time.Second / historyPoints)
+}
+
+// rollupsOver returns the stored rollups of the last m seconds, the last one
+// is the rollup of the interval being collected.
+func (cssd *CacheSysStatDumps) rollupsOver(m uint32, step time.Duration) []*api.SystemDump {
+	h := cssd.storedHistory()
+	if h == nil {
+		return nil
 	}
-	res.TT = &api.TopTalkers{}
-	res.TT.Ttp = make([]*api.TopTalkersProtocol, len(sysDump.TT.Ttp))
-	for i := range res.TT.Ttp {
-		res.TT.Ttp[i] = &api.TopTalkersProtocol{
-			Protocol: sysDump.TT.Ttp[i].Protocol,
-			Bytes:    sysDump.TT.Ttp[i].Bytes,
-			Rate:     sysDump.TT.Ttp[i].Rate,
-		}
+	to := time.Now()
+	dumps := make([]*api.SystemDump, 0, historyPoints+1)
+	err := h.Range(to.Add(-time.Duration(m)*time.Second), to, step, func(_ time.Time, dump *api.SystemDump) error {
+		dumps = append(dumps, dump)
+		return nil
+	})
+	if err != nil {
+		logger.Log.WithFields(logrus.Fields{
+			"file": "sniffer.go",
+			"func": "rollupsOver()",
+		}).Error(err.Error())
 	}
-	res.TT.Ttt = make([]*api.TopTalkersTraffic, len(sysDump.TT.Ttt))
-	for i := range res.TT.Ttt {
-		res.TT.Ttt[i] = &api.TopTalkersTraffic{
-			Source:      sysDump.TT.Ttt[i].Source,
-			Distination: sysDump.TT.Ttt[i].Distination,
-			Protocol:    sysDump.TT.Ttt[i].Protocol,
-			Bps:         sysDump.TT.Ttt[i].Bps,
-		}
+
+	return dumps
+}
+
+// cutRows sorts and cuts the top talkers, the listening sockets and
+// the processes by the request.
+func (cssd *CacheSysStatDumps) cutRows(res *api.SystemDump, in *api.GetSystemDumpRequest) {
+	k := int(in.GetTopK())
+	if res.TT != nil {
+		sysstats.SortTopTalkersProtocol(res.TT.Ttp, in.GetSortBy())
+		res.TT.Ttp = firstRows(res.TT.Ttp, k)
+		sysstats.SortTopTalkersTraffic(res.TT.Ttt, in.GetSortBy())
+		res.TT.Ttt = firstRows(res.TT.Ttt, k)
 	}
-	res.CS = &api.ConnectStats{}
-	res.CS.Ls = make([]*api.ListeningSocket, len(sysDump.CS.Ls))
-	for i := range res.CS.Ls {
-		res.CS.Ls[i] = &api.ListeningSocket{
-			Protocol: sysDump.CS.Ls[i].Protocol,
-			Port:     sysDump.CS.Ls[i].Port,
-			Pid:      sysDump.CS.Ls[i].Pid,
-			User:     sysDump.CS.Ls[i].User,
-			Command:  sysDump.CS.Ls[i].Command,
-		}
+	if res.CS != nil {
+		sysstats.SortListeningSockets(res.CS.Ls, in.GetSortBy())
+		res.CS.Ls = firstRows(res.CS.Ls, k)
 	}
-	res.CS.Conn = make([]*api.Connect, len(sysDump.CS.Conn))
-	for i := range res.CS.Conn {
-		res.CS.Conn[i] = &api.Connect{
-			State:  sysDump.CS.Conn[i].State,
-			Number: sysDump.CS.Conn[i].Number,
-		}
+	if res.TP != nil {
+		cssd.cutTopProcesses(res.TP, in)
 	}
+}
 
-	return res
+// firstRows returns the first k rows, all of them if k is 0.
+func firstRows[T any](rows []T, k int) []T {
+	if k > 0 && len(rows) > k {
+		return rows[:k]
+	}
+
+	return rows
+}
+
+// cutTopProcesses sorts and cuts the processes by the request or the server
+// config. The deprecated top_processes_k and top_processes_sort_by are
+// preferred to top_k and sort_by if they are set.
+func (cssd *CacheSysStatDumps) cutTopProcesses(res *api.TopProcesses, in *api.GetSystemDumpRequest) {
+	res.SortBy = cssd.config.TopProcesses.SortBy
+	if slices.Contains(config.ProcessSortKeys, in.GetSortBy()) {
+		res.SortBy = in.GetSortBy()
+	}
+	if in.GetTopProcessesSortBy() != "" {
+		res.SortBy = in.GetTopProcessesSortBy()
+	}
+	k := cssd.config.TopProcesses.K
+	if in.GetTopK() > 0 {
+		k = int(in.GetTopK())
+	}
+	if in.GetTopProcessesK() > 0 {
+		k = int(in.GetTopProcessesK())
+	}
+	sysstats.SortProcesses(res.Processes, res.SortBy)
+	if k > 0 && len(res.Processes) > k {
+		res.Processes = res.Processes[:k]
+	}
 }
@@ -0,0 +1,331 @@
+package systemdump
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lixoi/system_stats_daemon/config"
+	"github.com/lixoi/system_stats_daemon/internal/server/grpc/api"
+	"github.com/lixoi/system_stats_daemon/internal/storage"
+	"github.com/lixoi/system_stats_daemon/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSysStatDumpOver(t *testing.T) {
+	logger.Init("Debug")
+	cssd := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 10}})
+	start := time.Now()
+	for i := 1; i <= 4; i++ {
+		cssd.Buffer.Append(start.Add(time.Duration(i)*time.Second), &api.SystemDump{
+			LA: &api.LoadAverage{AvgOneMin: float64(i)},
+			LC: &api.LoadCPU{UserMode: float64(10 * i)},
+			CG: []*api.CgroupStats{{Path: "/system.slice", MemoryCurrent: uint64(100 * i), MemoryOomKill: uint64(i)}},
+			LD: []*api.LoadDisk{{DiskDevice: "sda", Tps: float64(i)}, {DiskDevice: "sdb"}},
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		empty := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 10}})
+		dump, _ := empty.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 5})
+		require.Nil(t, dump)
+	})
+
+	t.Run("last m", func(t *testing.T) {
+		dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 2})
+		require.Equal(t, 3.5, dump.LA.AvgOneMin)
+		require.Equal(t, float64(35), dump.LC.UserMode)
+		require.Equal(t, uint64(350), dump.CG[0].MemoryCurrent)
+		// counters keep the newest value
+		require.Equal(t, uint64(4), dump.CG[0].MemoryOomKill)
+	})
+
+	t.Run("more than kept", func(t *testing.T) {
+		dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 30})
+		require.Equal(t, float64(25), dump.LC.UserMode)
+	})
+
+	t.Run("aggregation", func(t *testing.T) {
+		for agg, want := range map[api.Aggregation]float64{
+			api.Aggregation_AGGREGATION_MIN:  1,
+			api.Aggregation_AGGREGATION_MAX:  4,
+			api.Aggregation_AGGREGATION_LAST: 4,
+			api.Aggregation_AGGREGATION_P50:  2,
+		} {
+			dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 4, Aggregation: agg})
+			require.Equal(t, want, dump.LA.AvgOneMin, agg.String())
+			require.Equal(t, 10*want, dump.LC.UserMode, agg.String())
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 2, Filter: &api.DumpFilter{Device: "sda"}})
+		require.Len(t, dump.LD, 1)
+		require.Equal(t, 3.5, dump.LD[0].Tps)
+		// the cached snapshots keep all the rows
+		dump, _ = cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 2})
+		require.Len(t, dump.LD, 2)
+	})
+
+	t.Run("top k and sort by", func(t *testing.T) {
+		rows := NewCacheSysStatDumps(config.Config{
+			Server:     config.ServerConf{Capacity: 10},
+			DumpFields: config.DumpConf{TopProcesses: config.TopProcessesConfig{K: 3, SortBy: config.SortByCPU}},
+		})
+		rows.Buffer.Append(start, &api.SystemDump{
+			TT: &api.TopTalkers{
+				Ttp: []*api.TopTalkersProtocol{{Protocol: "UDP", Rate: 20, Bytes: 900}, {Protocol: "TCP", Rate: 80, Bytes: 100}},
+				Ttt: []*api.TopTalkersTraffic{{Source: "a", Bps: 1}, {Source: "b", Bps: 3}, {Source: "c", Bps: 2}},
+			},
+			CS: &api.ConnectStats{Ls: []*api.ListeningSocket{{Port: 22, Pid: 9}, {Port: 80, Pid: 1}}},
+			TP: &api.TopProcesses{Processes: []*api.Process{
+				{Pid: 1, Cpu: 1, Rss: 30}, {Pid: 2, Cpu: 3, Rss: 10}, {Pid: 3, Cpu: 2, Rss: 20},
+			}},
+		})
+
+		dump, _ := rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{})
+		require.Equal(t, "TCP", dump.TT.Ttp[0].Protocol)
+		require.Equal(t, "b", dump.TT.Ttt[0].Source)
+		require.Equal(t, uint32(80), dump.CS.Ls[0].Port)
+		require.Len(t, dump.TP.Processes, 3)
+
+		dump, _ = rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{TopK: 1})
+		require.Len(t, dump.TT.Ttp, 1)
+		require.Len(t, dump.TT.Ttt, 1)
+		require.Equal(t, "b", dump.TT.Ttt[0].Source)
+		require.Len(t, dump.CS.Ls, 1)
+		require.Equal(t, uint32(2), dump.TP.Processes[0].Pid)
+
+		dump, _ = rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{TopK: 2, SortBy: config.SortByBytes})
+		require.Equal(t, "UDP", dump.TT.Ttp[0].Protocol)
+		// the sections without the key are sorted by their default one
+		require.Equal(t, uint32(80), dump.CS.Ls[0].Port)
+		require.Equal(t, config.SortByCPU, dump.TP.SortBy)
+
+		dump, _ = rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{TopK: 1, SortBy: config.SortByRSS, TopProcessesK: 2})
+		require.Equal(t, config.SortByRSS, dump.TP.SortBy)
+		require.Equal(t, []uint32{1, 3}, []uint32{dump.TP.Processes[0].Pid, dump.TP.Processes[1].Pid})
+		require.Len(t, dump.CS.Ls, 1)
+	})
+
+	t.Run("top k above the config k", func(t *testing.T) {
+		rows := NewCacheSysStatDumps(config.Config{
+			Server:     config.ServerConf{Capacity: 10},
+			DumpFields: config.DumpConf{TopProcesses: config.TopProcessesConfig{K: 2, SortBy: config.SortByCPU}},
+		})
+		// the collector keeps all the processes
+		rows.Buffer.Append(start, &api.SystemDump{TP: &api.TopProcesses{Processes: []*api.Process{
+			{Pid: 1, Cpu: 5, Rss: 1}, {Pid: 2, Cpu: 4, Rss: 2}, {Pid: 3, Cpu: 3, Rss: 3},
+			{Pid: 4, Cpu: 2, Rss: 4}, {Pid: 5, Cpu: 1, Rss: 5},
+		}}})
+
+		dump, _ := rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{})
+		require.Len(t, dump.TP.Processes, 2)
+
+		dump, _ = rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{TopK: 4})
+		pids := make([]uint32, 0, len(dump.TP.Processes))
+		for _, p := range dump.TP.Processes {
+			pids = append(pids, p.Pid)
+		}
+		require.Equal(t, []uint32{1, 2, 3, 4}, pids)
+
+		dump, _ = rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{TopProcessesK: 3, TopProcessesSortBy: config.SortByRSS})
+		require.Equal(t, uint32(5), dump.TP.Processes[0].Pid)
+		require.Equal(t, uint32(3), dump.TP.Processes[2].Pid)
+	})
+
+	t.Run("filter before top k", func(t *testing.T) {
+		rows := NewCacheSysStatDumps(config.Config{
+			Server:     config.ServerConf{Capacity: 10},
+			DumpFields: config.DumpConf{TopProcesses: config.TopProcessesConfig{K: 2, SortBy: config.SortByCPU}},
+		})
+		rows.Buffer.Append(start, &api.SystemDump{TP: &api.TopProcesses{Processes: []*api.Process{
+			{Pid: 1, Cpu: 5, User: "root"}, {Pid: 2, Cpu: 4, User: "root"}, {Pid: 3, Cpu: 3, User: "root"},
+			{Pid: 4, Cpu: 2, User: "postgres"}, {Pid: 5, Cpu: 1, User: "postgres", Command: "postgres: checkpointer"},
+		}}})
+
+		dump, _ := rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{Filter: &api.DumpFilter{User: "^postgres$"}})
+		require.Len(t, dump.TP.Processes, 2)
+		require.Equal(t, uint32(4), dump.TP.Processes[0].Pid)
+
+		dump, _ = rows.GetSysStatDumpOver(&api.GetSystemDumpRequest{Filter: &api.DumpFilter{Command: "checkpointer"}})
+		require.Len(t, dump.TP.Processes, 1)
+		require.Equal(t, uint32(5), dump.TP.Processes[0].Pid)
+	})
+
+	t.Run("zero m", func(t *testing.T) {
+		dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{})
+		require.Equal(t, float64(40), dump.LC.UserMode)
+	})
+}
+
+func TestLoadHistory(t *testing.T) {
+	logger.Init("Debug")
+	conf := config.StorageConfig{Directory: t.TempDir(), SegmentSize: config.DefaultStorageSegmentSize}
+	s, err := storage.OpenHistory(conf, averageDumps)
+	require.NoError(t, err)
+	now := time.Now()
+	for i := 10; i >= 1; i-- {
+		ts := now.Add(-time.Duration(i) * time.Second)
+		require.NoError(t, s.Append(ts, &api.SystemDump{
+			Timestamp: ts.UnixNano(),
+			LA:        &api.LoadAverage{AvgOneMin: float64(i)},
+		}))
+	}
+
+	cssd := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 3}})
+	cssd.history = s
+	cssd.loadHistory()
+	require.Equal(t, 3, cssd.Buffer.Len())
+	dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 3})
+	require.Equal(t, float64(2), dump.LA.AvgOneMin)
+
+	// a larger cache is filled with the older snapshots
+	cssd.ChangeSizeCache(6)
+	require.Equal(t, 6, cssd.Buffer.Len())
+	oldest, _, _ := cssd.Buffer.Oldest()
+	require.Equal(t, now.Add(-6*time.Second).UnixNano(), oldest.UnixNano())
+	require.NoError(t, s.Close())
+}
+
+func TestRequestsConcurrently(t *testing.T) {
+	logger.Init("Debug")
+	conf := config.StorageConfig{Directory: t.TempDir(), SegmentSize: config.DefaultStorageSegmentSize}
+	s, err := storage.OpenHistory(conf, averageDumps)
+	require.NoError(t, err)
+	now := time.Now()
+	for i := 100; i >= 1; i-- {
+		ts := now.Add(-time.Duration(i) * time.Second)
+		require.NoError(t, s.Append(ts, &api.SystemDump{Timestamp: ts.UnixNano()}))
+	}
+	cssd := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 3}})
+	cssd.history = s
+
+	// the requests growing the cache load the same stored snapshots
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(m int) {
+			defer wg.Done()
+			<-start
+			cssd.AddRequest(m)
+		}(10 + i%5)
+	}
+	close(start)
+	wg.Wait()
+	require.Equal(t, 14, cssd.Buffer.Cap())
+	dumps := cssd.Buffer.Last(cssd.Buffer.Len())
+	require.Len(t, dumps, 14)
+	for i := 1; i < len(dumps); i++ {
+		require.Less(t, dumps[i-1].Timestamp, dumps[i].Timestamp)
+	}
+
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(m int) {
+			defer wg.Done()
+			cssd.DelRequest(m)
+		}(10 + i%5)
+	}
+	wg.Wait()
+	require.Equal(t, 3, cssd.Buffer.Cap())
+	require.NoError(t, s.Close())
+}
+
+func TestGetSysStatDumpOverRollups(t *testing.T) {
+	logger.Init("Debug")
+	conf := config.StorageConfig{
+		Directory:   t.TempDir(),
+		SegmentSize: config.DefaultStorageSegmentSize,
+		Rollups:     []config.RollupConfig{{Resolution: 10 * time.Second}},
+	}
+	h, err := storage.OpenHistory(conf, averageDumps)
+	require.NoError(t, err)
+	defer h.Close()
+
+	cssd := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 10}})
+	cssd.history = h
+	now := time.Now()
+	for i := 900; i >= 1; i-- {
+		ts := now.Add(-time.Duration(i) * time.Second)
+		dump := &api.SystemDump{Timestamp: ts.UnixNano(), LC: &api.LoadCPU{UserMode: 10}}
+		if i <= 300 {
+			dump.LC.UserMode = 40
+		}
+		require.NoError(t, h.Append(ts, dump))
+		cssd.Buffer.Append(ts, dump)
+	}
+
+	t.Run("short window", func(t *testing.T) {
+		require.Equal(t, time.Duration(0), cssd.rollupStep(599))
+		dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 5})
+		require.Equal(t, float64(40), dump.LC.UserMode)
+	})
+
+	t.Run("long window", func(t *testing.T) {
+		require.Equal(t, 10*time.Second, cssd.rollupStep(600))
+		dump, _ := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 600})
+		// a half of the window at 10, a half at 40, up to the edge rollups
+		require.InDelta(t, 25, dump.LC.UserMode, 1)
+	})
+
+	t.Run("long window aggregation", func(t *testing.T) {
+		dump, agg := cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 600, Aggregation: api.Aggregation_AGGREGATION_MAX})
+		// the rollups keep only the means of their intervals
+		require.Equal(t, api.Aggregation_AGGREGATION_MEAN, agg)
+		require.InDelta(t, 25, dump.LC.UserMode, 1)
+
+		dump, agg = cssd.GetSysStatDumpOver(&api.GetSystemDumpRequest{M: 5, Aggregation: api.Aggregation_AGGREGATION_MAX})
+		require.Equal(t, api.Aggregation_AGGREGATION_MAX, agg)
+		require.Equal(t, float64(40), dump.LC.UserMode)
+	})
+
+	t.Run("cache size", func(t *testing.T) {
+		cssd.ChangeSizeCache(3600)
+		require.Equal(t, 599, cssd.Buffer.Cap())
+	})
+}
+
+func TestGetSysStatDumpHistory(t *testing.T) {
+	logger.Init("Debug")
+	cssd := NewCacheSysStatDumps(config.Config{Server: config.ServerConf{Capacity: 30}})
+	start := time.Now().Truncate(10 * time.Second).Add(-20 * time.Second)
+	for i := 0; i < 20; i++ {
+		ts := start.Add(time.Duration(i) * time.Second)
+		cssd.Buffer.Append(ts, &api.SystemDump{Timestamp: ts.UnixNano(), LC: &api.LoadCPU{UserMode: float64(i)}})
+	}
+	history := func(t *testing.T, in *api.GetSystemDumpHistoryRequest) (values []float64, stamps []int64) {
+		t.Helper()
+		err := cssd.GetSysStatDumpHistory(in, func(dump *api.SystemDump) error {
+			values = append(values, dump.LC.UserMode)
+			stamps = append(stamps, dump.Timestamp)
+			return nil
+		})
+		require.NoError(t, err)
+		return values, stamps
+	}
+
+	t.Run("snapshots", func(t *testing.T) {
+		values, stamps := history(t, &api.GetSystemDumpHistoryRequest{
+			From: start.Add(3 * time.Second).UnixNano(),
+			To:   start.Add(5 * time.Second).UnixNano(),
+		})
+		require.Equal(t, []float64{3, 4, 5}, values)
+		require.Equal(t, start.Add(3*time.Second).UnixNano(), stamps[0])
+	})
+
+	t.Run("step", func(t *testing.T) {
+		values, stamps := history(t, &api.GetSystemDumpHistoryRequest{From: start.UnixNano(), Step: 10})
+		require.Equal(t, []float64{4.5, 14.5}, values)
+		require.Equal(t, []int64{start.UnixNano(), start.Add(10 * time.Second).UnixNano()}, stamps)
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		values, _ := history(t, &api.GetSystemDumpHistoryRequest{
+			From: start.Add(-time.Hour).UnixNano(),
+			To:   start.Add(-time.Minute).UnixNano(),
+		})
+		require.Empty(t, values)
+	})
+}